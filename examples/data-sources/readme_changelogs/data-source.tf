@@ -0,0 +1,19 @@
+# Retrieve every changelog, newest first.
+data "readme_changelogs" "example" {}
+
+output "example_changelogs" {
+  value = data.readme_changelogs.example.results
+}
+
+# Summarize the visible features added in 2024, oldest first.
+data "readme_changelogs" "added" {
+  type           = "added"
+  hidden         = false
+  created_after  = "2024-01-01T00:00:00Z"
+  created_before = "2025-01-01T00:00:00Z"
+  sort           = "asc"
+}
+
+output "release_summary" {
+  value = join("\n", [for changelog in data.readme_changelogs.added.results : "${changelog.created_at}: ${changelog.title}"])
+}
@@ -7,3 +7,13 @@ output "example_doc_search" {
   value = data.readme_doc_search.example
 }
 
+# Search the first 10 visible guides in a category of version 2.0, returning the highlights as plain text.
+data "readme_doc_search" "filtered" {
+  query            = "authentication"
+  version          = "2.0"
+  type             = "doc"
+  category_slug    = "getting-started"
+  hidden           = false
+  limit            = 10
+  strip_highlights = true
+}
@@ -0,0 +1,19 @@
+# List every doc in the stable version.
+data "readme_docs" "example" {}
+
+output "example_docs" {
+  value = data.readme_docs.example.docs
+}
+
+# List the hidden docs in version 2.0.
+data "readme_docs" "hidden" {
+  version = "2.0"
+  hidden  = true
+}
+
+# List the docs updated in the last 30 days, with their details.
+data "readme_docs" "recent" {
+  updated_after = timeadd(timestamp(), "-720h")
+  hydrate       = true
+  parallelism   = 8
+}
@@ -0,0 +1,9 @@
+# Report the broken internal links in the docs of a version.
+data "readme_link_report" "example" {
+  # version is optional. The stable version is used if it's not set.
+  version = "1.1.0"
+}
+
+output "example_broken_links" {
+  value = data.readme_link_report.example.broken_links
+}
@@ -10,3 +10,16 @@ output "example_versions" {
 output "example_version_detail" {
   value = tolist(data.readme_versions.example.versions)[0].version
 }
+
+# Retrieve the latest public 2.x version.
+data "readme_versions" "v2" {
+  constraint         = ">= 2.0, < 3"
+  include_beta       = false
+  include_hidden     = false
+  include_deprecated = false
+  sort_by_semver     = true
+}
+
+output "latest_v2" {
+  value = data.readme_versions.v2.latest.version_clean
+}
@@ -5,6 +5,11 @@ provider "readme" {
 
   # Set the API token here or with the README_API_TOKEN env var.
   # api_token = ""
+
+  # Validate the front matter of doc, changelog, and custom page bodies. In
+  # "strict" mode, unsupported keys and keys that conflict with attributes are
+  # errors. Defaults to "lenient".
+  # front_matter_mode = "strict"
 }
 
 terraform {
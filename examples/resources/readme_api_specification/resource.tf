@@ -7,6 +7,13 @@ resource "readme_api_specification" "example" {
   # not deleted when the API specification is deleted. Set this parameter to
   # true to delete the category when the API specification is deleted.
   delete_category = true
+
+  # Processing a large specification can take several minutes. Each operation
+  # defaults to a 20 minute timeout.
+  timeouts {
+    create = "30m"
+    update = "30m"
+  }
 }
 
 # Output the ID of the created resource.
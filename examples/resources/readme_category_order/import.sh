@@ -0,0 +1,2 @@
+# Import the order of all categories in a version using the version string.
+terraform import readme_category_order.example 1.0.0
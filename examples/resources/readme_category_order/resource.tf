@@ -0,0 +1,34 @@
+# The "readme_category_order" resource manages the sidebar order of categories
+# in a version.
+resource "readme_category" "getting_started" {
+  title = "Getting Started"
+  type  = "guide"
+}
+
+resource "readme_category" "guides" {
+  title = "Guides"
+  type  = "guide"
+}
+
+resource "readme_category" "faq" {
+  title = "FAQ"
+  type  = "guide"
+}
+
+# Order the categories in the stable version. Warn if there are categories in
+# the version that aren't in the list.
+resource "readme_category_order" "example" {
+  categories = [
+    readme_category.getting_started.slug,
+    readme_category.guides.slug,
+    readme_category.faq.slug,
+  ]
+
+  unmanaged = "warn"
+}
+
+# Order categories in a specific version.
+resource "readme_category_order" "v2" {
+  version    = "2.0"
+  categories = ["getting-started", "guides", "faq"]
+}
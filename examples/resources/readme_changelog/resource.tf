@@ -8,3 +8,20 @@ resource "readme_changelog" "example" {
   # body can be read from a file using Terraform's `file()` or `templatefile()` functions.
   body = "* Added support for foo\n* Added support for bar"
 }
+
+# Manage a changelog with the body read from a local file.
+resource "readme_changelog" "example_file" {
+  type        = "improved"
+  source_file = "${path.module}/changelogs/my-changelog.md"
+}
+
+# Wait until the changelog is indexed for search before continuing.
+resource "readme_changelog" "example_search" {
+  title  = "My Searchable Changelog"
+  type   = "added"
+  hidden = false
+  body   = "* Added support for baz"
+
+  wait_for_search_index = true
+  search_index_timeout  = "2m"
+}
@@ -0,0 +1,14 @@
+# Publish a changelog for each release in a Keep a Changelog file.
+resource "readme_changelog_file" "example" {
+  source_file = "${path.module}/CHANGELOG.md"
+
+  # Publish the changelogs as visible, titled like "v1.2.0".
+  hidden       = false
+  title_prefix = "v"
+
+  # Releases with more than one section, such as both "### Added" and "### Fixed", are published with this type.
+  combined_type = "improved"
+
+  # Don't publish the "## [Unreleased]" release.
+  include_unreleased = false
+}
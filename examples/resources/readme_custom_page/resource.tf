@@ -16,3 +16,9 @@ resource "readme_custom_page" "example_html" {
   html_mode = true
   html      = file("my-custom-page.html")
 }
+
+# Example reading the body from a local file.
+resource "readme_custom_page" "example_file" {
+  title       = "My Example Custom Page"
+  source_file = "${path.module}/my-custom-page.md"
+}
@@ -33,3 +33,54 @@ resource "readme_doc" "example" {
   #body = chomp(file("mydoc.md"))
   body = "Hello! Welcome to my document!"
 }
+
+# Create a doc with the body read from a local file.
+# The file's checksum is tracked and only a summary of changed lines is shown
+# in the plan. Set store_body to false to store a checksum of the body in the
+# state instead of its content.
+#
+# With upload_images enabled, local images referenced in the body, such as
+# `![diagram](./img/flow.png)`, are uploaded to ReadMe and the references are
+# rewritten to the uploaded URLs. Images are only uploaded again when their
+# content changes.
+#
+# Docs written for GitHub can use body_format = "gfm" to convert GitHub alerts,
+# relative links to other Markdown files, and titled code blocks to their
+# ReadMe equivalents.
+#
+# Code samples can be embedded from local files with an include directive,
+# such as `{{< include "../examples/client.go" region="auth" >}}`. The region
+# is marked in the file with `// #region auth` and `// #endregion auth`
+# comments. Includes are expanded during the plan, so a change to an included
+# file updates the doc.
+#
+# Set link_check to "warn" or "error" to validate links to other docs, such as
+# `doc:setup`, against the docs in the same version during the plan.
+resource "readme_doc" "example_file" {
+  category      = readme_category.example.id
+  source_file   = "${path.module}/docs/my-doc.md"
+  body_format   = "gfm"
+  link_check    = "warn"
+  store_body    = false
+  upload_images = true
+}
+
+# Render the body as a template to publish the same doc for several products.
+# Variables can be used in the front matter and the body. A reference to an
+# undefined variable fails the plan.
+resource "readme_doc" "example_template" {
+  category = readme_category.example.id
+  type     = "basic"
+
+  body = <<-EOT
+  ---
+  title: "{{ .product }} Quickstart"
+  ---
+  Install {{ .product }} from {{ .base_url }}.
+  EOT
+
+  template_vars = {
+    product  = "Example"
+    base_url = "https://example.com"
+  }
+}
@@ -7,3 +7,20 @@ output "image_info" {
   value = readme_image.example
 }
 
+# Resize a screenshot, convert it to JPEG, and remove its metadata before it's uploaded.
+resource "readme_image" "screenshot" {
+  source    = "example.png"
+  max_width = 1200
+  format    = "jpeg"
+  quality   = 85
+}
+
+# Upload an image generated by another resource from its base64-encoded contents.
+resource "readme_image" "diagram" {
+  content_base64 = filebase64("example.png")
+}
+
+# Upload a remote image, which is fetched with the provider's HTTP client on every plan.
+resource "readme_image" "remote" {
+  source_url = "https://example.com/images/architecture.png"
+}
@@ -0,0 +1,12 @@
+# Upload every screenshot in a directory, scaled down to fit the page width.
+resource "readme_images" "assets" {
+  directory   = "${path.module}/docs/assets"
+  patterns    = ["*.png", "*.jpg"]
+  max_width   = 1200
+  parallelism = 8
+}
+
+# Reference an uploaded image by its path relative to the directory.
+output "setup_screenshot_url" {
+  value = readme_images.assets.images["screenshots/setup.png"].url
+}
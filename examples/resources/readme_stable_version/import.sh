@@ -0,0 +1,2 @@
+# The stable version can be imported using its version number.
+terraform import readme_stable_version.example 2.0.0
@@ -0,0 +1,22 @@
+# The "readme_stable_version" resource manages which version is the project's
+# stable version. Leave "is_stable" unset on "readme_version" resources when
+# using this resource.
+resource "readme_version" "v1" {
+  version = "1.0.0"
+  from    = "1.0.0"
+}
+
+resource "readme_version" "v2" {
+  version = "2.0.0"
+  from    = readme_version.v1.version_clean
+}
+
+# Promote version 2.0.0 to the stable version.
+resource "readme_stable_version" "example" {
+  version = readme_version.v2.version_clean
+}
+
+# Output the version that was stable before the promotion.
+output "previous_stable_version" {
+  value = readme_stable_version.example.previous_version
+}
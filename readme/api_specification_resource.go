@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,6 +22,10 @@ import (
 	"github.com/liveoaklabs/readme-api-go-client/readme"
 )
 
+// apiSpecificationPollInterval is how long to wait between checks of whether a saved specification has been
+// processed.
+var apiSpecificationPollInterval = 5 * time.Second
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &apiSpecificationResource{}
@@ -34,17 +40,18 @@ type apiSpecificationResource struct {
 
 // apiSpecificationResourceModel maps the struct from the ReadMe client library to Terraform attributes.
 type apiSpecificationResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Category       types.Object `tfsdk:"category"`
-	DeleteCategory types.Bool   `tfsdk:"delete_category"`
-	UUID           types.String `tfsdk:"uuid"`
-	Definition     types.String `tfsdk:"definition"`
-	LastSynced     types.String `tfsdk:"last_synced"`
-	Semver         types.String `tfsdk:"semver"`
-	Source         types.String `tfsdk:"source"`
-	Title          types.String `tfsdk:"title"`
-	Type           types.String `tfsdk:"type"`
-	Version        types.String `tfsdk:"version"`
+	ID             types.String   `tfsdk:"id"`
+	Category       types.Object   `tfsdk:"category"`
+	DeleteCategory types.Bool     `tfsdk:"delete_category"`
+	UUID           types.String   `tfsdk:"uuid"`
+	Definition     types.String   `tfsdk:"definition"`
+	LastSynced     types.String   `tfsdk:"last_synced"`
+	Semver         types.String   `tfsdk:"semver"`
+	Source         types.String   `tfsdk:"source"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	Title          types.String   `tfsdk:"title"`
+	Type           types.String   `tfsdk:"type"`
+	Version        types.String   `tfsdk:"version"`
 }
 
 // NewAPISpecificationResource is a helper function to simplify the provider implementation.
@@ -71,7 +78,7 @@ func (r *apiSpecificationResource) Configure(
 		return
 	}
 
-	r.client = req.ProviderData.(*readme.Client)
+	r.client = req.ProviderData.(*providerData).client
 }
 
 // jsonMatch compares two JSON strings without regards to formatting and returns a bool.
@@ -118,7 +125,7 @@ func specCategoryObject(spec readme.APISpecification) basetypes.ObjectValue {
 
 // Schema defines the API Specification resource attributes.
 func (r *apiSpecificationResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -130,6 +137,10 @@ func (r *apiSpecificationResource) Schema(
 			"the definition is created in the API registry is necessary for retrieving the " +
 			"remote definition. This behavior is undocumented in the ReadMe API documentation but works the same way " +
 			"the official ReadMe `rdme` CLI tool works.\n\n" +
+			"ReadMe processes an API specification after it's uploaded. The provider waits until the " +
+			"specification's category and reference pages exist and its `last_synced` timestamp is updated " +
+			"before saving the state. This may take several minutes for large specifications and is limited by " +
+			"the `create` and `update` timeouts.\n\n" +
 			"## External Changes\n\n" +
 			"External changes made to an API specification managed by Terraform will not be detected due to the way " +
 			"the API registry works. When a specification definition is updated, the registry UUID changes and is " +
@@ -222,6 +233,9 @@ func (r *apiSpecificationResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -239,8 +253,11 @@ func (r *apiSpecificationResource) Create(
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Create, operationCreate, &resp.Diagnostics)
+	defer done()
+
 	// Create the specification.
-	plan, err := r.save(ctx, saveActionCreate, "", plan)
+	plan, err := r.save(ctx, saveActionCreate, "", "", plan)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create API specification.", err.Error())
 
@@ -269,6 +286,9 @@ func (r *apiSpecificationResource) Read(
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Read, operationRead, &resp.Diagnostics)
+	defer done()
+
 	// Track the current state definition.
 	currentDefinition := state.Definition
 
@@ -290,10 +310,7 @@ func (r *apiSpecificationResource) Read(
 				return
 			}
 
-			resp.Diagnostics.AddError(
-				fmt.Sprintf("Unable to read API specification: %+v", apiResponse.APIErrorResponse.Error),
-				clientError(err, apiResponse),
-			)
+			resp.Diagnostics.AddError("Unable to read API specification.", clientError(err, apiResponse))
 
 			return
 		}
@@ -324,6 +341,7 @@ func (r *apiSpecificationResource) Read(
 	}
 
 	state.DeleteCategory = plan.DeleteCategory
+	state.Timeouts = plan.Timeouts
 
 	// Compare the local state with the remote definition.
 	// The JSON keys/values are compared between the local and remote definition without regards to whitespace.
@@ -356,8 +374,11 @@ func (r *apiSpecificationResource) Update(
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Update, operationUpdate, &resp.Diagnostics)
+	defer done()
+
 	// Create the specification.
-	plan, err := r.save(ctx, saveActionUpdate, state.ID.ValueString(), plan)
+	plan, err := r.save(ctx, saveActionUpdate, state.ID.ValueString(), state.LastSynced.ValueString(), plan)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update API specification.", err.Error())
 
@@ -384,6 +405,9 @@ func (r *apiSpecificationResource) Delete(
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Delete, operationDelete, &resp.Diagnostics)
+	defer done()
+
 	_, apiResponse, err := r.client.APISpecification.Delete(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -434,12 +458,14 @@ func (r *apiSpecificationResource) ImportState(
 // The provided plan definition is created in the ReadMe API registry, followed by a create or update of the
 // specification itself using the registry UUID.
 //
-// After creation or update, the specification is retrieved and `makePlan()` is called to map the results to the
-// Terraform resource schema that is returned.
+// After creation or update, `waitForProcessing()` waits until ReadMe has processed the specification, which must
+// have been last synced after `lastSynced`. The specification is then retrieved and `makePlan()` is called to map
+// the results to the Terraform resource schema that is returned.
 func (r *apiSpecificationResource) save(
 	ctx context.Context,
 	action saveAction,
-	specID string, plan apiSpecificationResourceModel,
+	specID, lastSynced string,
+	plan apiSpecificationResourceModel,
 ) (apiSpecificationResourceModel, error) {
 	var registry readme.APIRegistrySaved
 	var response readme.APISpecificationSaved
@@ -488,7 +514,14 @@ func (r *apiSpecificationResource) save(
 		)
 	}
 
+	// ReadMe processes the specification asynchronously after it's saved.
+	err = r.waitForProcessing(ctx, response.ID, version, lastSynced, plan.Definition.ValueString())
+	if err != nil {
+		return apiSpecificationResourceModel{}, err
+	}
+
 	deleteCategory := plan.DeleteCategory
+	configuredTimeouts := plan.Timeouts
 
 	// Get the spec plan.
 	plan, err = r.makePlan(ctx, response.ID, plan.Definition, registry.RegistryUUID, version)
@@ -497,6 +530,7 @@ func (r *apiSpecificationResource) save(
 	}
 
 	plan.DeleteCategory = deleteCategory
+	plan.Timeouts = configuredTimeouts
 
 	return plan, nil
 }
@@ -543,6 +577,121 @@ func (r *apiSpecificationResource) makePlan(
 	return plan, nil
 }
 
+// waitForProcessing retrieves a saved specification until ReadMe has finished processing it, which is when the
+// specification's category exists, it was last synced after `lastSynced`, and the reference pages in its category
+// exist. Reference pages are only expected if the definition has paths.
+//
+// The wait is limited by the deadline of the context, which is set by the resource's timeouts. When the deadline
+// passes, the returned error describes what ReadMe was still processing at the last successful check.
+func (r *apiSpecificationResource) waitForProcessing(
+	ctx context.Context,
+	specID, version, lastSynced, definition string,
+) error {
+	pending := "the specification has not been retrieved"
+
+	for {
+		if ctx.Err() != nil {
+			return fmt.Errorf("the API specification %s was not processed by ReadMe: %s", specID, pending)
+		}
+
+		current, err := r.processingPending(ctx, specID, version, lastSynced, definition)
+		if err != nil {
+			// Requests are canceled when the deadline passes, which isn't the reason the wait failed.
+			if ctx.Err() != nil {
+				return fmt.Errorf("the API specification %s was not processed by ReadMe: %s", specID, pending)
+			}
+
+			return err
+		}
+
+		pending = current
+		if pending == "" {
+			return nil
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("waiting for API specification %s to be processed: %s", specID, pending))
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(apiSpecificationPollInterval):
+		}
+	}
+}
+
+// processingPending returns a description of what ReadMe has not yet finished processing for a specification, or
+// an empty string if the specification is processed.
+func (r *apiSpecificationResource) processingPending(
+	ctx context.Context,
+	specID, version, lastSynced, definition string,
+) (string, error) {
+	spec, err := r.get(ctx, specID, version)
+	if err != nil {
+		return "", err
+	}
+
+	if spec.Category.Slug == "" {
+		return "the category has not been created", nil
+	}
+
+	if !syncedAfter(spec.LastSynced, lastSynced) {
+		return "the specification has not been synced", nil
+	}
+
+	if !definitionHasPaths(definition) {
+		return "", nil
+	}
+
+	docs, apiResponse, err := categoryDocSlugs(r.client, spec.Category.Slug, readme.RequestOptions{Version: version})
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve the reference pages of specification id %s: %s",
+			specID, clientError(err, apiResponse))
+	}
+
+	if len(docs) == 0 {
+		return "the reference pages have not been created", nil
+	}
+
+	return "", nil
+}
+
+// syncedAfter returns whether a specification's `last_synced` timestamp is after a previous one. Any timestamp is
+// after an empty previous timestamp, such as when the specification is created.
+func syncedAfter(lastSynced, previous string) bool {
+	if lastSynced == "" {
+		return false
+	}
+
+	if previous == "" {
+		return true
+	}
+
+	lastSyncedTime, err := time.Parse(time.RFC3339, lastSynced)
+	if err != nil {
+		return lastSynced != previous
+	}
+
+	previousTime, err := time.Parse(time.RFC3339, previous)
+	if err != nil {
+		return lastSynced != previous
+	}
+
+	return lastSyncedTime.After(previousTime)
+}
+
+// definitionHasPaths returns whether an API specification definition has any paths, which ReadMe creates reference
+// pages for.
+func definitionHasPaths(definition string) bool {
+	var spec struct {
+		Paths map[string]any `json:"paths"`
+	}
+
+	if err := json.Unmarshal([]byte(definition), &spec); err != nil {
+		return false
+	}
+
+	return len(spec.Paths) > 0
+}
+
 // get is a helper function that retrieves a specification by ID and returns a readme.APISpecification struct.
 func (r *apiSpecificationResource) get(ctx context.Context, specID, version string) (readme.APISpecification, error) {
 	requestOptions := readme.RequestOptions{Version: version}
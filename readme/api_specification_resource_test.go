@@ -3,9 +3,12 @@ package readme
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
 	"github.com/liveoaklabs/terraform-provider-readme/internal/testdata"
 	"gopkg.in/h2non/gock.v1"
 )
@@ -91,3 +94,208 @@ func TestAPISpecificationResource_CreateDeleteCategory(t *testing.T) {
 		},
 	})
 }
+
+// apiSpecificationProcessingGocks mocks creating an API specification with paths that ReadMe processes
+// asynchronously. The specification is listed without a category and its category has no reference pages the
+// given number of times before they're created.
+func apiSpecificationProcessingGocks(definition string, unprocessed int) {
+	unprocessedSpec := testdata.APISpecifications[0]
+	unprocessedSpec.Category = readme.CategorySummary{}
+
+	gock.OffAll()
+	gock.New(testURL).
+		Post("/api-registry").
+		Times(1).
+		Reply(201).
+		JSON(`{"registryUUID": "abcdefghijklmno", "definition": ` + definition + `}`)
+	gock.New(testURL).Get("/api-registry").Persist().Reply(200).JSON(definition)
+	gock.New(testURL).Post("/api-specification").Times(1).Reply(201).JSON(testdata.APISpecificationSavedResponse)
+	gock.New(testURL).Get("/version").Times(1).Reply(200).JSON(mockVersionList)
+	gock.New(testURL).
+		Get("/version/" + mockVersionList[0].VersionClean).
+		Times(1).
+		Reply(200).
+		JSON(mockVersionList[0])
+
+	for _, specs := range [][]readme.APISpecification{{unprocessedSpec}, testdata.APISpecifications} {
+		request := gock.New(testURL).
+			Get("/api-specification").
+			MatchParam("perPage", "100").
+			MatchParam("page", "1")
+
+		if specs[0].Category.Slug == "" {
+			request = request.Times(unprocessed)
+		} else {
+			request = request.Persist()
+		}
+
+		request.
+			Reply(200).
+			SetHeaders(map[string]string{"link": `<>; rel="next", <>; rel="prev", <>; rel="last"`}).
+			JSON(specs)
+	}
+
+	gock.New(testURL).
+		Get("/categories/" + testdata.APISpecifications[0].Category.Slug + "/docs").
+		Times(1).
+		Reply(200).
+		JSON([]readme.CategoryDocs{})
+	gock.New(testURL).
+		Get("/categories/" + testdata.APISpecifications[0].Category.Slug + "/docs").
+		Persist().
+		Reply(200).
+		JSON([]readme.CategoryDocs{{ID: "1", Slug: "list-pets", Title: "List pets"}})
+	gock.New(testURL).Delete("/api-specification").Times(1).Reply(204)
+}
+
+// TestAPISpecificationResource_Processing tests that the resource waits until ReadMe has processed the API
+// specification before saving the state.
+func TestAPISpecificationResource_Processing(t *testing.T) {
+	// Check for processing often so the tests don't wait.
+	defaultPollInterval := apiSpecificationPollInterval
+	apiSpecificationPollInterval = 10 * time.Millisecond
+	defer func() { apiSpecificationPollInterval = defaultPollInterval }()
+
+	definition := `{"openapi":"3.0.0","info":{"version":"1.1.1","title":"Test API Spec"},` +
+		`"paths":{"/pets":{"get":{"tags":["pets"]}}}}`
+
+	testCases := []struct {
+		name        string
+		unprocessed int
+		timeouts    string
+		check       resource.TestCheckFunc
+		error       *regexp.Regexp
+	}{
+		{
+			name:        "processed",
+			unprocessed: 2,
+			check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(
+					"readme_api_specification.test",
+					"category.slug",
+					testdata.APISpecifications[0].Category.Slug,
+				),
+				resource.TestCheckResourceAttr(
+					"readme_api_specification.test",
+					"last_synced",
+					testdata.APISpecifications[0].LastSynced,
+				),
+			),
+		},
+		{
+			name:        "not processed before the timeout",
+			unprocessed: 1000,
+			timeouts:    `timeouts { create = "1s" }`,
+			error: regexp.MustCompile(
+				`(?s)the category has not been created.*The create operation did not finish within 1s`,
+			),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Close all gocks when completed.
+			defer gock.OffAll()
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						PreConfig: func() { apiSpecificationProcessingGocks(definition, testCase.unprocessed) },
+						Config: providerConfig + fmt.Sprintf(`
+							resource "readme_api_specification" "test" {
+								definition = %q
+								%s
+							}`,
+							definition,
+							testCase.timeouts,
+						),
+						Check:       testCase.check,
+						ExpectError: testCase.error,
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestAPISpecificationResource_Processing_Update tests that an update waits until ReadMe has synced the API
+// specification again and that the wait ends when the update times out.
+func TestAPISpecificationResource_Processing_Update(t *testing.T) {
+	// Check for processing often so the tests don't wait.
+	defaultPollInterval := apiSpecificationPollInterval
+	apiSpecificationPollInterval = 10 * time.Millisecond
+	defer func() { apiSpecificationPollInterval = defaultPollInterval }()
+
+	// Close all gocks when completed.
+	defer gock.OffAll()
+
+	definition := `{"openapi":"3.0.0","info":{"version":"1.1.1","title":"Test API Spec"},` +
+		`"paths":{"/pets":{"get":{"tags":["pets"]}}}}`
+	updatedDefinition := `{"openapi":"3.0.0","info":{"version":"1.1.2","title":"Test API Spec"},` +
+		`"paths":{"/pets":{"get":{"tags":["pets"]}}}}`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { apiSpecificationProcessingGocks(definition, 1) },
+				Config: providerConfig + fmt.Sprintf(`
+					resource "readme_api_specification" "test" {
+						definition = %q
+					}`,
+					definition,
+				),
+			},
+			// ReadMe never syncs the updated specification, so `last_synced` doesn't advance.
+			{
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Post("/api-registry").
+						Persist().
+						Reply(201).
+						JSON(`{"registryUUID": "abcdefghijklmno", "definition": ` + updatedDefinition + `}`)
+					gock.New(testURL).Get("/api-registry").Persist().Reply(200).JSON(definition)
+					gock.New(testURL).
+						Put("/api-specification/" + testdata.APISpecifications[0].ID).
+						Persist().
+						Reply(200).
+						JSON(testdata.APISpecificationSavedResponse)
+					gock.New(testURL).
+						Get("/version/" + mockVersionList[0].VersionClean).
+						Persist().
+						Reply(200).
+						JSON(mockVersionList[0])
+					gock.New(testURL).Get("/version").Persist().Reply(200).JSON(mockVersionList)
+					gock.New(testURL).
+						Get("/api-specification").
+						MatchParam("perPage", "100").
+						MatchParam("page", "1").
+						Persist().
+						Reply(200).
+						SetHeaders(map[string]string{"link": `<>; rel="next", <>; rel="prev", <>; rel="last"`}).
+						JSON(testdata.APISpecifications)
+					gock.New(testURL).
+						Get("/categories/" + testdata.APISpecifications[0].Category.Slug + "/docs").
+						Persist().
+						Reply(200).
+						JSON([]readme.CategoryDocs{{ID: "1", Slug: "list-pets", Title: "List pets"}})
+					gock.New(testURL).Delete("/api-specification").Persist().Reply(204)
+				},
+				Config: providerConfig + fmt.Sprintf(`
+					resource "readme_api_specification" "test" {
+						definition = %q
+						timeouts { update = "1s" }
+					}`,
+					updatedDefinition,
+				),
+				ExpectError: regexp.MustCompile(
+					`(?s)the specification has not been synced.*The update operation did not finish within 1s`,
+				),
+			},
+		},
+	})
+}
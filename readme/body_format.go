@@ -0,0 +1,80 @@
+package readme
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/liveoaklabs/terraform-provider-readme/readme/gfm"
+)
+
+const (
+	// bodyFormatReadMe is the body format for ReadMe-flavored Markdown, which is sent to the API as-is.
+	bodyFormatReadMe = "readme"
+
+	// bodyFormatGFM is the body format for GitHub-flavored Markdown, which is converted to ReadMe-flavored
+	// Markdown before it's sent to the API.
+	bodyFormatGFM = "gfm"
+)
+
+// bodyFormatSchema returns the resource schema attribute for the format of a body.
+// This is shared by the readme_changelog, readme_custom_page, and readme_doc resources.
+func bodyFormatSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"body_format": schema.StringAttribute{
+			Description: "The Markdown format of the body. Set to `gfm` to convert GitHub-flavored Markdown to " +
+				"ReadMe-flavored Markdown before it's published. GitHub alerts (`> [!NOTE]`) are converted to " +
+				"callouts, relative links to other Markdown files (`./setup.md`) are converted to doc links " +
+				"(`doc:setup`), and consecutive fenced code blocks with a `title` or `tab` attribute " +
+				"(```` ```js title=\"Node\" ````) are converted to code tabs. The `body_clean` attribute reflects " +
+				"the converted body. Must be one of `readme` or `gfm`. Defaults to `readme`.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(bodyFormatReadMe),
+		},
+	}
+}
+
+// validateBodyFormat returns an error diagnostic if the body format is not a supported value.
+func validateBodyFormat(format types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if format.IsNull() || format.IsUnknown() {
+		return diags
+	}
+
+	switch format.ValueString() {
+	case bodyFormatReadMe, bodyFormatGFM:
+	default:
+		diags.AddAttributeError(
+			path.Root("body_format"),
+			"Invalid body format.",
+			fmt.Sprintf("body_format must be one of '%s' or '%s', got '%s'.",
+				bodyFormatReadMe, bodyFormatGFM, format.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// formatBody returns the body converted to ReadMe-flavored Markdown from the given format.
+func formatBody(body string, format types.String) string {
+	if format.ValueString() == bodyFormatGFM {
+		return gfm.Convert(body)
+	}
+
+	return body
+}
+
+// bodyFormatValue returns the body format, defaulting to ReadMe-flavored Markdown when it's not set, such as
+// when a resource is imported.
+func bodyFormatValue(format types.String) types.String {
+	if format.IsNull() || format.IsUnknown() {
+		return types.StringValue(bodyFormatReadMe)
+	}
+
+	return format
+}
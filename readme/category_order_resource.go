@@ -0,0 +1,540 @@
+package readme
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &categoryOrderResource{}
+	_ resource.ResourceWithConfigure      = &categoryOrderResource{}
+	_ resource.ResourceWithImportState    = &categoryOrderResource{}
+	_ resource.ResourceWithModifyPlan     = &categoryOrderResource{}
+	_ resource.ResourceWithValidateConfig = &categoryOrderResource{}
+)
+
+const (
+	// unmanagedCategoriesIgnore ignores categories that are not in the ordered list.
+	unmanagedCategoriesIgnore = "ignore"
+	// unmanagedCategoriesWarn adds a warning for categories that are not in the ordered list.
+	unmanagedCategoriesWarn = "warn"
+	// unmanagedCategoriesError adds an error for categories that are not in the ordered list.
+	unmanagedCategoriesError = "error"
+)
+
+// categoryOrderResource is the resource implementation.
+type categoryOrderResource struct {
+	client *readme.Client
+}
+
+// categoryOrderResourceModel maps the order of categories in a version to Terraform resource attributes.
+type categoryOrderResourceModel struct {
+	Categories          types.List     `tfsdk:"categories"`
+	ID                  types.String   `tfsdk:"id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	Unmanaged           types.String   `tfsdk:"unmanaged"`
+	UnmanagedCategories types.List     `tfsdk:"unmanaged_categories"`
+	Version             types.String   `tfsdk:"version"`
+}
+
+// categoryOrderParams is the request body for updating a category's order.
+//
+// The API requires the title and type to be sent when updating a category, so the current values are sent
+// along with the new order.
+type categoryOrderParams struct {
+	Order int    `json:"order"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+}
+
+// NewCategoryOrderResource is a helper function to simplify the provider implementation.
+func NewCategoryOrderResource() resource.Resource {
+	return &categoryOrderResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *categoryOrderResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_category_order"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *categoryOrderResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerData).client
+}
+
+// Schema defines the category order resource attributes.
+func (r *categoryOrderResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the sidebar order of categories in a version of a project on ReadMe.com\n\n" +
+			"The order of every listed category is applied in a single pass. Only categories whose position " +
+			"differs are updated. Categories reordered in the ReadMe dashboard are detected as drift on the " +
+			"next plan.\n\n" +
+			"The `order` attribute of `readme_category` resources will change when this resource is applied. " +
+			"Destroying this resource removes it from the Terraform state without changing the order of any " +
+			"category.\n\n" +
+			"See <https://docs.readme.com/main/reference/updatecategory> for more information about this API " +
+			"endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"categories": schema.ListAttribute{
+				Description: "The ordered list of category slugs. The first category is shown first in the " +
+					"sidebar. Each category must exist in the version.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource, which is the version the categories are ordered in.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"unmanaged": schema.StringAttribute{
+				Description: "How to handle categories in the version that are not in the `categories` list. " +
+					"Must be one of `ignore`, `warn`, or `error`. Unmanaged categories are checked during the " +
+					"plan. Defaults to `ignore`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(unmanagedCategoriesIgnore),
+			},
+			"unmanaged_categories": schema.ListAttribute{
+				Description: "The slugs of categories in the version that are not in the `categories` list, " +
+					"in their current order.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "The version the categories are in. If not set, the project's stable version is " +
+					"used. Changing the version will replace the resource.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ValidateConfig validates the list of categories and the unmanaged setting.
+func (r categoryOrderResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data categoryOrderResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unmanaged := data.Unmanaged.ValueString()
+	if !data.Unmanaged.IsNull() && !data.Unmanaged.IsUnknown() &&
+		unmanaged != unmanagedCategoriesIgnore &&
+		unmanaged != unmanagedCategoriesWarn &&
+		unmanaged != unmanagedCategoriesError {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("unmanaged"),
+			"Invalid unmanaged value.",
+			fmt.Sprintf("unmanaged must be one of 'ignore', 'warn', or 'error', got '%s'.", unmanaged),
+		)
+	}
+
+	if data.Categories.IsUnknown() {
+		return
+	}
+
+	// Each category may only be listed once.
+	seen := map[string]bool{}
+	for _, element := range data.Categories.Elements() {
+		slug, ok := element.(types.String)
+		if !ok || slug.IsUnknown() || slug.IsNull() {
+			continue
+		}
+
+		if seen[slug.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("categories"),
+				"Duplicate category.",
+				fmt.Sprintf("Category '%s' is listed more than once.", slug.ValueString()),
+			)
+		}
+
+		seen[slug.ValueString()] = true
+	}
+}
+
+// ModifyPlan checks for categories that are not in the ordered list.
+func (r *categoryOrderResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var plan *categoryOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	// Nothing to check when destroying or when values aren't known yet.
+	if resp.Diagnostics.HasError() || plan == nil || plan.Categories.IsUnknown() {
+		return
+	}
+
+	if plan.Unmanaged.ValueString() == unmanagedCategoriesIgnore || plan.Unmanaged.IsUnknown() {
+		return
+	}
+
+	// The version is computed when it isn't set, in which case the stable version is used.
+	version := plan.Version
+	if version.IsUnknown() {
+		var versionConfig types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version"), &versionConfig)...)
+		if !versionConfig.IsNull() {
+			return
+		}
+
+		version = types.StringValue("")
+	}
+
+	// Categories that will be created during the apply aren't known yet.
+	slugs := []string{}
+	for _, element := range plan.Categories.Elements() {
+		slug, ok := element.(types.String)
+		if !ok || slug.IsUnknown() {
+			return
+		}
+
+		slugs = append(slugs, slug.ValueString())
+	}
+
+	categories, apiResponse, err := r.client.Category.GetAll(apiRequestOptions(version))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve categories.", clientError(err, apiResponse))
+
+		return
+	}
+
+	unmanaged := unmanagedCategories(categories, slugs)
+	if len(unmanaged) == 0 {
+		return
+	}
+
+	summary := "Categories not in the ordered list."
+	detail := fmt.Sprintf("The following categories are in the version but not in the categories list: %s\n\n"+
+		"Add the categories to the list to manage their order, or set unmanaged = \"ignore\".",
+		strings.Join(unmanaged, ", "))
+
+	if plan.Unmanaged.ValueString() == unmanagedCategoriesError {
+		resp.Diagnostics.AddAttributeError(path.Root("categories"), summary, detail)
+
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(path.Root("categories"), summary, detail)
+}
+
+// Create applies the order of the categories and sets the initial Terraform state.
+func (r *categoryOrderResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan categoryOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Create, operationCreate, &resp.Diagnostics)
+	defer done()
+
+	state, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to set category order.", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the current order of the categories.
+func (r *categoryOrderResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state categoryOrderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Read, operationRead, &resp.Diagnostics)
+	defer done()
+
+	state, apiResponse, err := r.get(ctx, state)
+	if err != nil {
+		if apiResponse != nil && apiResponse.APIErrorResponse.Error == "VERSION_NOTFOUND" {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to read category order.", clientError(err, apiResponse))
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the order of the categories and sets the updated Terraform state on success.
+func (r *categoryOrderResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan categoryOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Update, operationUpdate, &resp.Diagnostics)
+	defer done()
+
+	state, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to set category order.", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete removes the resource from the Terraform state. The order of the categories is not changed.
+func (r *categoryOrderResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
+
+// ImportState imports the order of all categories in a version by the version string.
+func (r *categoryOrderResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("version"), req, resp)
+}
+
+// apply sets the order of each category to its position in the planned list and returns the resulting state.
+//
+// Every listed category is verified to exist before any category is updated. Categories that are already in
+// the correct position are not updated.
+func (r *categoryOrderResource) apply(
+	ctx context.Context,
+	plan categoryOrderResourceModel,
+) (categoryOrderResourceModel, error) {
+	slugs := []string{}
+	if diags := plan.Categories.ElementsAs(ctx, &slugs, false); diags.HasError() {
+		return plan, fmt.Errorf("unable to parse the list of categories")
+	}
+
+	options := apiRequestOptions(plan.Version)
+
+	categories, apiResponse, err := r.client.Category.GetAll(options)
+	if err != nil {
+		return plan, fmt.Errorf("unable to retrieve categories: %s", clientError(err, apiResponse))
+	}
+
+	bySlug := map[string]readme.Category{}
+	for _, category := range categories {
+		bySlug[category.Slug] = category
+	}
+
+	missing := []string{}
+	for _, slug := range slugs {
+		if _, ok := bySlug[slug]; !ok {
+			missing = append(missing, slug)
+		}
+	}
+
+	if len(missing) > 0 {
+		return plan, fmt.Errorf("the following categories were not found in the version: %s",
+			strings.Join(missing, ", "))
+	}
+
+	for position, slug := range slugs {
+		category := bySlug[slug]
+		if category.Order == position {
+			continue
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("moving category %s from position %d to %d", slug, category.Order, position))
+
+		apiResponse, err := r.setOrder(category, position, options)
+		if err != nil {
+			return plan, fmt.Errorf("unable to set the order of category %s: %s",
+				slug, clientError(err, apiResponse))
+		}
+	}
+
+	state, apiResponse, err := r.get(ctx, plan)
+	if err != nil {
+		return plan, fmt.Errorf("unable to read the category order after applying it: %s",
+			clientError(err, apiResponse))
+	}
+
+	return state, nil
+}
+
+// setOrder updates the order of a single category.
+func (r *categoryOrderResource) setOrder(
+	category readme.Category,
+	order int,
+	options readme.RequestOptions,
+) (*readme.APIResponse, error) {
+	payload, err := json.Marshal(categoryOrderParams{
+		Order: order,
+		Title: category.Title,
+		Type:  category.Type,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse request: %w", err)
+	}
+
+	var response readme.Category
+
+	return r.client.APIRequest(&readme.APIRequest{
+		Method:         "PUT",
+		Endpoint:       fmt.Sprintf("%s/%s", readme.CategoryEndpoint, category.Slug),
+		UseAuth:        true,
+		Payload:        payload,
+		Headers:        []readme.RequestHeader{{"Content-Type": "application/json"}},
+		OkStatusCode:   []int{200},
+		Response:       &response,
+		RequestOptions: options,
+	})
+}
+
+// get retrieves the categories in the version and maps their current order to the resource model.
+//
+// The managed categories are listed in their current remote order so that categories reordered outside of
+// Terraform show as a difference from the configured list. When no categories are tracked in the state, such
+// as after an import, every category in the version is managed.
+func (r *categoryOrderResource) get(
+	ctx context.Context,
+	state categoryOrderResourceModel,
+) (categoryOrderResourceModel, *readme.APIResponse, error) {
+	slugs := []string{}
+	if !state.Categories.IsNull() && !state.Categories.IsUnknown() {
+		if diags := state.Categories.ElementsAs(ctx, &slugs, false); diags.HasError() {
+			return state, nil, fmt.Errorf("unable to parse the list of categories")
+		}
+	}
+
+	categories, apiResponse, err := r.client.Category.GetAll(apiRequestOptions(state.Version))
+	if err != nil {
+		return state, apiResponse, err
+	}
+
+	// Preserve the configured position to keep the sort stable when categories share the same order.
+	position := map[string]int{}
+	for i, slug := range slugs {
+		position[slug] = i
+	}
+
+	sort.SliceStable(categories, func(i, j int) bool {
+		if categories[i].Order != categories[j].Order {
+			return categories[i].Order < categories[j].Order
+		}
+
+		return position[categories[i].Slug] < position[categories[j].Slug]
+	})
+
+	managed := []string{}
+	for _, category := range categories {
+		if _, ok := position[category.Slug]; ok || len(slugs) == 0 {
+			managed = append(managed, category.Slug)
+		}
+	}
+
+	state.Categories, _ = types.ListValueFrom(ctx, types.StringType, managed)
+	state.UnmanagedCategories, _ = types.ListValueFrom(ctx, types.StringType, unmanagedCategories(categories, managed))
+
+	if state.Version.ValueString() == "" && len(categories) > 0 {
+		state.Version = types.StringValue(versionClean(ctx, r.client, categories[0].Version))
+	}
+
+	if state.Version.IsNull() || state.Version.IsUnknown() {
+		state.Version = types.StringValue("")
+	}
+
+	if state.Unmanaged.IsNull() || state.Unmanaged.IsUnknown() {
+		state.Unmanaged = types.StringValue(unmanagedCategoriesIgnore)
+	}
+
+	state.ID = state.Version
+
+	return state, apiResponse, nil
+}
+
+// unmanagedCategories returns the slugs of categories that are not in a list of slugs, in the order of the
+// categories.
+func unmanagedCategories(categories []readme.Category, slugs []string) []string {
+	managed := map[string]bool{}
+	for _, slug := range slugs {
+		managed[slug] = true
+	}
+
+	unmanaged := []string{}
+	for _, category := range categories {
+		if !managed[category.Slug] {
+			unmanaged = append(unmanaged, category.Slug)
+		}
+	}
+
+	return unmanaged
+}
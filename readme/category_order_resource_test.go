@@ -0,0 +1,192 @@
+// nolint:goconst // Intentional repetition of some values for tests.
+package readme
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"gopkg.in/h2non/gock.v1"
+)
+
+// mockOrderedCategories returns a list of categories with the given slugs in order.
+func mockOrderedCategories(slugs ...string) []readme.Category {
+	categories := []readme.Category{}
+
+	for i, slug := range slugs {
+		category := mockCategory
+		category.ID = fmt.Sprintf("63b891d3ee384600680cea1%d", i)
+		category.Order = i
+		category.Slug = slug
+		category.Title = slug
+		categories = append(categories, category)
+	}
+
+	return categories
+}
+
+// mockCategoryListOrder mocks the request for the list of categories in a version.
+// A times value of 0 persists the mock.
+func mockCategoryListOrder(times int, categories []readme.Category) {
+	mock := gock.New(testURL).
+		Get("/categories").
+		MatchParam("page", "1").
+		MatchHeader("x-readme-version", mockVersion.VersionClean)
+
+	if times > 0 {
+		mock = mock.Times(times)
+	} else {
+		mock = mock.Persist()
+	}
+
+	mock.Reply(200).
+		AddHeader("link", `<>; rel="next", <>; rel="prev", <>; rel="last"`).
+		JSON(categories)
+}
+
+func TestCategoryOrderResource(t *testing.T) {
+	before := mockOrderedCategories("alpha", "bravo", "charlie", "delta")
+	after := mockOrderedCategories("bravo", "alpha", "charlie", "delta")
+
+	config := func(unmanaged string) string {
+		return providerConfig + `resource "readme_category_order" "test" {
+			version    = "` + mockVersion.VersionClean + `"
+			categories = ["bravo", "alpha", "charlie"]
+			unmanaged  = "` + unmanaged + `"
+		}`
+	}
+
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test applying the order. Only the categories that moved are updated.
+			{
+				Config: config("ignore"),
+				PreConfig: func() {
+					gock.OffAll()
+					mockCategoryListOrder(1, before)
+					gock.New(testURL).
+						Put("/categories/bravo").
+						MatchType("json").
+						JSON(categoryOrderParams{Order: 0, Title: "bravo", Type: "guide"}).
+						Times(1).
+						Reply(200).
+						JSON(after[0])
+					gock.New(testURL).
+						Put("/categories/alpha").
+						MatchType("json").
+						JSON(categoryOrderParams{Order: 1, Title: "alpha", Type: "guide"}).
+						Times(1).
+						Reply(200).
+						JSON(after[1])
+					mockCategoryListOrder(0, after)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_category_order.test", "id", mockVersion.VersionClean),
+					resource.TestCheckResourceAttr("readme_category_order.test", "categories.#", "3"),
+					resource.TestCheckResourceAttr("readme_category_order.test", "categories.0", "bravo"),
+					resource.TestCheckResourceAttr("readme_category_order.test", "categories.1", "alpha"),
+					resource.TestCheckResourceAttr("readme_category_order.test", "categories.2", "charlie"),
+					resource.TestCheckResourceAttr(
+						"readme_category_order.test",
+						"unmanaged_categories.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"readme_category_order.test",
+						"unmanaged_categories.0",
+						"delta",
+					),
+				),
+			},
+			// Test that reordering outside of Terraform is detected as drift.
+			{
+				Config:             config("ignore"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				PreConfig: func() {
+					gock.OffAll()
+					mockCategoryListOrder(0, before)
+				},
+			},
+			// Test that unmanaged categories are flagged when unmanaged is "error".
+			{
+				Config:      config("error"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Categories not in the ordered list"),
+				PreConfig: func() {
+					gock.OffAll()
+					mockCategoryListOrder(0, after)
+				},
+			},
+			// Test importing, which manages every category in the version.
+			{
+				ResourceName:  "readme_category_order.test",
+				ImportState:   true,
+				ImportStateId: mockVersion.VersionClean,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+
+					attrs := states[0].Attributes
+					if attrs["categories.#"] != "4" || attrs["categories.3"] != "delta" {
+						return fmt.Errorf("expected all 4 categories to be imported, got %v", attrs)
+					}
+
+					return nil
+				},
+				PreConfig: func() {
+					gock.OffAll()
+					mockCategoryListOrder(0, after)
+				},
+			},
+		},
+	})
+}
+
+func TestCategoryOrderResource_Error(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test listing a category more than once.
+			{
+				Config: providerConfig + `resource "readme_category_order" "test" {
+					categories = ["alpha", "alpha"]
+				}`,
+				ExpectError: regexp.MustCompile("Category 'alpha' is listed more than once"),
+			},
+			// Test an invalid unmanaged value.
+			{
+				Config: providerConfig + `resource "readme_category_order" "test" {
+					categories = ["alpha"]
+					unmanaged  = "invalid"
+				}`,
+				ExpectError: regexp.MustCompile("unmanaged must be one of"),
+			},
+			// Test a category that doesn't exist in the version.
+			{
+				Config: providerConfig + `resource "readme_category_order" "test" {
+					version    = "` + mockVersion.VersionClean + `"
+					categories = ["alpha", "missing"]
+				}`,
+				ExpectError: regexp.MustCompile("categories were not found in the version: missing"),
+				PreConfig: func() {
+					gock.OffAll()
+					mockCategoryListOrder(0, mockOrderedCategories("alpha"))
+				},
+			},
+		},
+	})
+}
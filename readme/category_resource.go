@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -29,6 +32,23 @@ type categoryResource struct {
 	client *readme.Client
 }
 
+// categoryResourceModel maps a category to the resource schema data.
+type categoryResourceModel struct {
+	CategoryType types.String   `tfsdk:"category_type"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	ForceDestroy types.Bool     `tfsdk:"force_destroy"`
+	ID           types.String   `tfsdk:"id"`
+	Order        types.Int64    `tfsdk:"order"`
+	Project      types.String   `tfsdk:"project"`
+	Reference    types.Bool     `tfsdk:"reference"`
+	Slug         types.String   `tfsdk:"slug"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+	Title        types.String   `tfsdk:"title"`
+	Type         types.String   `tfsdk:"type"`
+	Version      types.String   `tfsdk:"version"`
+	VersionID    types.String   `tfsdk:"version_id"`
+}
+
 // NewCategoryResource is a helper function to simplify the provider
 // implementation.
 func NewCategoryResource() resource.Resource {
@@ -54,7 +74,7 @@ func (r *categoryResource) Configure(
 		return
 	}
 
-	r.client = req.ProviderData.(*readme.Client)
+	r.client = req.ProviderData.(*providerData).client
 }
 
 // ValidateConfig is used for validating attribute values.
@@ -63,7 +83,7 @@ func (r categoryResource) ValidateConfig(
 	req resource.ValidateConfigRequest,
 	resp *resource.ValidateConfigResponse,
 ) {
-	var data categoryModel
+	var data categoryResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -80,7 +100,7 @@ func (r categoryResource) ValidateConfig(
 }
 
 func (r *categoryResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -102,6 +122,14 @@ func (r *categoryResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "Allow the category to be destroyed while it still contains docs. When false, " +
+					"destroying a category that contains docs or child docs fails and lists the remaining docs. " +
+					"Deleting a category on ReadMe deletes every doc in it.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"id": schema.StringAttribute{
 				Description: "The ID of the category.",
 				Computed:    true,
@@ -164,6 +192,9 @@ func (r *categoryResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -174,13 +205,16 @@ func (r *categoryResource) Create(
 	resp *resource.CreateResponse,
 ) {
 	// Retrieve values from plan.
-	var plan categoryModel
+	var plan categoryResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Create, operationCreate, &resp.Diagnostics)
+	defer done()
+
 	// Create the category.
 	createParams := readme.CategoryParams{
 		Title: plan.Title.ValueString(),
@@ -243,12 +277,15 @@ func (r *categoryResource) Read(
 	resp *resource.ReadResponse,
 ) {
 	// Get current state.
-	var state categoryModel
+	var state categoryResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Read, operationRead, &resp.Diagnostics)
+	defer done()
+
 	// Determine the version using the version ID in the state.
 	version := versionClean(ctx, r.client, state.VersionID.ValueString())
 
@@ -289,13 +326,16 @@ func (r *categoryResource) Update(
 	resp *resource.UpdateResponse,
 ) {
 	// Retrieve values from plan and current state.
-	var plan, state categoryModel
+	var plan, state categoryResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Update, operationUpdate, &resp.Diagnostics)
+	defer done()
+
 	// Update the category.
 	createParams := readme.CategoryParams{
 		Title: plan.Title.ValueString(),
@@ -335,12 +375,39 @@ func (r *categoryResource) Delete(
 	resp *resource.DeleteResponse,
 ) {
 	// Retrieve values from state.
-	var state categoryModel
+	var state categoryResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	_, done := withTimeout(ctx, &r.client, state.Timeouts.Delete, operationDelete, &resp.Diagnostics)
+	defer done()
+
+	// Refuse to delete a category that still contains docs unless force_destroy is enabled.
+	if !state.ForceDestroy.ValueBool() {
+		docs, apiResponse, err := categoryDocSlugs(r.client, state.Slug.ValueString(), apiRequestOptions(state.Version))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to delete category %s.", state.Slug),
+				"There was a problem retrieving the docs in the category.\n"+clientError(err, apiResponse),
+			)
+
+			return
+		}
+
+		if len(docs) > 0 {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to delete category %s.", state.Slug),
+				fmt.Sprintf("The category contains %d doc(s): %s\n\n", len(docs), summarizeSlugs(docs))+
+					"Deleting the category would delete these docs. Move or delete the docs first, or set "+
+					"force_destroy = true on the category to delete it anyway.",
+			)
+
+			return
+		}
+	}
+
 	// Delete the category.
 	_, apiResponse, err := r.client.Category.Delete(
 		state.Slug.ValueString(),
@@ -368,10 +435,10 @@ func (r *categoryResource) ImportState(
 func (r *categoryResource) get(
 	ctx context.Context,
 	slug string,
-	plan categoryModel,
+	plan categoryResourceModel,
 	options readme.RequestOptions,
-) (categoryModel, *readme.APIResponse, error) {
-	var state categoryModel
+) (categoryResourceModel, *readme.APIResponse, error) {
+	var state categoryResourceModel
 
 	// Get the version from ReadMe.
 	response, apiResponse, err := r.client.Category.Get(slug, options)
@@ -379,7 +446,7 @@ func (r *categoryResource) get(
 		return state, apiResponse, errors.New(clientError(err, apiResponse))
 	}
 
-	state = categoryModel{
+	state = categoryResourceModel{
 		CategoryType: types.StringValue(response.CategoryType),
 		CreatedAt:    types.StringValue(response.CreatedAt),
 		ID:           types.StringValue(response.ID),
@@ -387,11 +454,54 @@ func (r *categoryResource) get(
 		Project:      types.StringValue(response.Project),
 		Reference:    types.BoolValue(response.Reference),
 		Slug:         types.StringValue(response.Slug),
+		Timeouts:     plan.Timeouts,
 		Title:        types.StringValue(response.Title),
 		Type:         types.StringValue(response.Type),
 		Version:      types.StringValue(versionClean(ctx, r.client, response.Version)),
 		VersionID:    types.StringValue(response.Version),
 	}
 
+	// force_destroy is not tracked by the API.
+	state.ForceDestroy = plan.ForceDestroy
+	if state.ForceDestroy.IsNull() || state.ForceDestroy.IsUnknown() {
+		state.ForceDestroy = types.BoolValue(false)
+	}
+
 	return state, apiResponse, nil
 }
+
+// categoryDocSlugs returns the slugs of every doc and child doc in a category.
+//
+// The docs are retrieved with the same request used by the readme_category_docs data source.
+func categoryDocSlugs(
+	client *readme.Client,
+	slug string,
+	options readme.RequestOptions,
+) ([]string, *readme.APIResponse, error) {
+	docs, apiResponse, err := client.Category.GetDocs(slug, options)
+	if err != nil {
+		return nil, apiResponse, err
+	}
+
+	slugs := []string{}
+	for _, doc := range docs {
+		slugs = append(slugs, doc.Slug)
+		for _, child := range doc.Children {
+			slugs = append(slugs, child.Slug)
+		}
+	}
+
+	return slugs, apiResponse, nil
+}
+
+// summarizeSlugs returns a comma-separated list of slugs for a diagnostic message, truncated after the first
+// few entries.
+func summarizeSlugs(slugs []string) string {
+	const limit = 10
+
+	if len(slugs) <= limit {
+		return strings.Join(slugs, ", ")
+	}
+
+	return fmt.Sprintf("%s and %d more", strings.Join(slugs[:limit], ", "), len(slugs)-limit)
+}
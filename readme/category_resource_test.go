@@ -112,6 +112,18 @@ func createCategoryTestStep(check resource.TestCheckFunc) resource.TestStep {
 	}
 }
 
+// mockCategoryDocs mocks the request for the docs in a category.
+//
+// The mock is registered before other category mocks so that the request is not matched by a mock for the
+// category itself.
+func mockCategoryDocs(slug string, docs []readme.CategoryDocs) {
+	gock.New(testURL).
+		Get("/categories/" + slug + "/docs").
+		Persist().
+		Reply(200).
+		JSON(docs)
+}
+
 // TestCategoryResource performs basic functionality testing of successfully
 // creating, reading, updating, importing, and deleting a category resource.
 func TestCategoryResource(t *testing.T) {
@@ -136,6 +148,8 @@ func TestCategoryResource(t *testing.T) {
 				PreConfig: func() {
 					// Ensure any existing mocks are removed.
 					gock.OffAll()
+					// Pre-delete check for docs in the category.
+					mockCategoryDocs(mockCategory.Slug, []readme.CategoryDocs{})
 					// Read current category.
 					gock.New(testURL).
 						Get("/categories/" + mockCategory.Slug).
@@ -183,6 +197,8 @@ func TestCategoryResource(t *testing.T) {
 				PreConfig: func() {
 					// Ensure any existing mocks are removed.
 					gock.OffAll()
+					// Pre-delete check for docs in the category.
+					mockCategoryDocs(mockCategory.Slug, []readme.CategoryDocs{})
 					gock.New(testURL).
 						Get("/categories/" + mockCategory.Slug).
 						Times(2).
@@ -252,6 +268,8 @@ func TestCategoryResource_ReCreate(t *testing.T) {
 				PreConfig: func() {
 					// Ensure any existing mocks are removed.
 					gock.OffAll()
+					// Pre-delete check for docs in the category.
+					mockCategoryDocs(mockCategory.Slug, []readme.CategoryDocs{})
 					// Read current category and return a 404.
 					mockAPIError.Error = "CATEGORY_NOTFOUND"
 					gock.New(testURL).
@@ -376,6 +394,8 @@ func TestCategoryResource_Read_Error(t *testing.T) {
 				ExpectError: regexp.MustCompile("Unable to read category"),
 				PreConfig: func() {
 					gock.OffAll()
+					// Pre-delete check for docs in the category.
+					mockCategoryDocs(mockCategoryCreate.Slug, []readme.CategoryDocs{})
 					// Return a 500 on a read request on an existing category.
 					mockAPIError.Error = "SERVER_ERROR"
 					gock.New(testURL).
@@ -418,6 +438,8 @@ func TestCategoryResource_Update_Error(t *testing.T) {
 				ExpectError: regexp.MustCompile("Unable to update category"),
 				PreConfig: func() {
 					gock.OffAll()
+					// Pre-delete check for docs in the category.
+					mockCategoryDocs(mockCategory.Slug, []readme.CategoryDocs{})
 					// Request existing category.
 					gock.New(testURL).
 						Get("/categories/" + mockCategoryCreate.Slug).
@@ -467,6 +489,8 @@ func TestCategoryResource_Post_Update_Read_Error(t *testing.T) {
 				ExpectError: regexp.MustCompile("Unable to update category"),
 				PreConfig: func() {
 					gock.OffAll()
+					// Pre-delete check for docs in the category.
+					mockCategoryDocs(mockCategory.Slug, []readme.CategoryDocs{})
 					// Request existing category.
 					gock.New(testURL).
 						Get("/categories/" + mockCategoryCreate.Slug).
@@ -524,6 +548,8 @@ func TestCategoryResource_Delete_Error(t *testing.T) {
 				}`,
 				PreConfig: func() {
 					gock.OffAll()
+					// Pre-delete check for docs in the category.
+					mockCategoryDocs(mockCategoryCreate.Slug, []readme.CategoryDocs{})
 					// Request existing category.
 					gock.New(testURL).
 						Get("/categories/" + mockCategoryCreate.Slug).
@@ -552,3 +578,89 @@ func TestCategoryResource_Delete_Error(t *testing.T) {
 		},
 	})
 }
+
+// TestCategoryResource_Delete_Docs tests that a category containing docs is not deleted unless force_destroy
+// is enabled.
+func TestCategoryResource_Delete_Docs(t *testing.T) {
+	mockDocs := []readme.CategoryDocs{
+		{
+			ID:    "6398a4a594b26e00885e7ec0",
+			Slug:  "parent-doc",
+			Title: "Parent Doc",
+			Children: []readme.CategoryDocsChildren{
+				{
+					ID:    "6398a4a594b26e00885e7ec1",
+					Slug:  "child-doc",
+					Title: "Child Doc",
+				},
+			},
+		},
+	}
+
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the category.
+			createCategoryTestStep(nil),
+			// Test that deleting a category with docs is refused.
+			{
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("The category contains 2 doc\\(s\\): parent-doc, child-doc"),
+				Config: providerConfig + `resource "readme_category" "test" {
+					title = "` + mockCategoryCreate.Title + `"
+					type  = "` + mockCategoryCreate.Type + `"
+				}`,
+				PreConfig: func() {
+					gock.OffAll()
+					mockCategoryDocs(mockCategoryCreate.Slug, mockDocs)
+					gock.New(testURL).
+						Get("/categories/" + mockCategoryCreate.Slug).
+						Persist().
+						Reply(200).
+						JSON(mockCategory)
+					gock.New(testURL).
+						Get("/version").
+						Persist().
+						Reply(200).
+						JSON(mockVersionList)
+				},
+			},
+			// Test that enabling force_destroy allows the category to be deleted.
+			{
+				Config: providerConfig + `resource "readme_category" "test" {
+					title         = "` + mockCategoryCreate.Title + `"
+					type          = "` + mockCategoryCreate.Type + `"
+					force_destroy = true
+				}`,
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Get("/categories/" + mockCategoryCreate.Slug).
+						Persist().
+						Reply(200).
+						JSON(mockCategory)
+					gock.New(testURL).
+						Put("/categories/" + mockCategory.Slug).
+						Times(1).
+						Reply(200).
+						JSON(mockCategory)
+					// Post-test delete without checking for docs.
+					gock.New(testURL).
+						Delete("/categories/" + mockCategory.Slug).
+						Times(1).
+						Reply(204)
+					gock.New(testURL).
+						Get("/version").
+						Persist().
+						Reply(200).
+						JSON(mockVersionList)
+				},
+				Check: resource.TestCheckResourceAttr("readme_category.test", "force_destroy", "true"),
+			},
+		},
+	})
+}
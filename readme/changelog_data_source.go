@@ -121,84 +121,96 @@ func (d *changelogDataSource) Configure(
 	d.client = req.ProviderData.(*readme.Client)
 }
 
-// Schema for the readme_changelog data source.
-func (d *changelogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Retrieve a changelog from the ReadMe API.\n\n" +
-			"See <https://docs.readme.com/reference/getchangelog> for more information about the API.",
-		// nolint:goconst
-		Attributes: map[string]schema.Attribute{
-			"algolia": schema.SingleNestedAttribute{
-				Description: "Metadata about the Algolia search integration. " +
-					"See <https://docs.readme.com/main/docs/search> for more information.",
-				Computed: true,
-				Attributes: map[string]schema.Attribute{
-					"publish_pending": schema.BoolAttribute{
-						Computed: true,
-					},
-					"record_count": schema.Int64Attribute{
-						Computed: true,
-					},
-					"updated_at": schema.StringAttribute{
-						Computed: true,
-					},
+// changelogDataSourceSchema returns the schema for the
+// readme_changelog and readme_changelogs data sources.
+func changelogDataSourceSchema() map[string]schema.Attribute {
+	// nolint:goconst // Attribute descriptions are
+	// repeated across resources and data sources.
+	return map[string]schema.Attribute{
+		"algolia": schema.SingleNestedAttribute{
+			Description: "Metadata about the Algolia search integration. " +
+				"See <https://docs.readme.com/main/docs/search> for more information.",
+			Computed: true,
+			Attributes: map[string]schema.Attribute{
+				"publish_pending": schema.BoolAttribute{
+					Computed: true,
 				},
-			},
-			"body": schema.StringAttribute{
-				Description: "The body of the changelog.",
-				Computed:    true,
-			},
-			"created_at": schema.StringAttribute{
-				Description: "The date the changelog was created.",
-				Computed:    true,
-			},
-			"hidden": schema.BoolAttribute{
-				Description: "Whether the changelog is hidden.",
-				Computed:    true,
-			},
-			"html": schema.StringAttribute{
-				Description: "The HTML of the changelog.",
-				Computed:    true,
-			},
-			"id": schema.StringAttribute{
-				Description: "The ID of the changelog.",
-				Computed:    true,
-			},
-			"metadata": schema.SingleNestedAttribute{
-				Computed: true,
-				Attributes: map[string]schema.Attribute{
-					"description": schema.StringAttribute{
-						Computed: true,
-					},
-					"image": schema.ListAttribute{
-						Computed:    true,
-						ElementType: types.StringType,
-					},
-					"title": schema.StringAttribute{
-						Computed: true,
-					},
+				"record_count": schema.Int64Attribute{
+					Computed: true,
+				},
+				"updated_at": schema.StringAttribute{
+					Computed: true,
 				},
 			},
-			"revision": schema.Int64Attribute{
-				Description: "The revision of the changelog.",
-				Computed:    true,
-			},
-			"slug": schema.StringAttribute{
-				Description: "The slug of the changelog.",
-				Required:    true,
-			},
-			"title": schema.StringAttribute{
-				Description: "The title of the changelog.",
-				Computed:    true,
-			},
-			"type": schema.StringAttribute{
-				Description: "The type of the changelog.",
-				Computed:    true,
-			},
-			"updated_at": schema.StringAttribute{
-				Description: "The date the changelog was last updated.",
-				Computed:    true,
+		},
+		"body": schema.StringAttribute{
+			Description: "The body of the changelog.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The date the changelog was created.",
+			Computed:    true,
+		},
+		"hidden": schema.BoolAttribute{
+			Description: "Whether the changelog is hidden.",
+			Computed:    true,
+		},
+		"html": schema.StringAttribute{
+			Description: "The HTML of the changelog.",
+			Computed:    true,
+		},
+		"id": schema.StringAttribute{
+			Description: "The ID of the changelog.",
+			Computed:    true,
+		},
+		"metadata": schema.SingleNestedAttribute{
+			Computed: true,
+			Attributes: map[string]schema.Attribute{
+				"description": schema.StringAttribute{
+					Computed: true,
+				},
+				"image": schema.ListAttribute{
+					Computed:    true,
+					ElementType: types.StringType,
+				},
+				"title": schema.StringAttribute{
+					Computed: true,
+				},
 			},
 		},
+		"revision": schema.Int64Attribute{
+			Description: "The revision of the changelog.",
+			Computed:    true,
+		},
+		"slug": schema.StringAttribute{
+			Description: "The slug of the changelog.",
+			Computed:    true,
+		},
+		"title": schema.StringAttribute{
+			Description: "The title of the changelog.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of the changelog.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The date the changelog was last updated.",
+			Computed:    true,
+		},
+	}
+}
+
+// Schema for the readme_changelog data source.
+func (d *changelogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := changelogDataSourceSchema()
+	attrs["slug"] = schema.StringAttribute{
+		Description: "The slug of the changelog.",
+		Required:    true,
+	}
+	resp.Schema = schema.Schema{
+		Description: "Retrieve a changelog from the ReadMe API.\n\n" +
+			"See <https://docs.readme.com/reference/getchangelog> for more information about the API.",
+		Attributes: attrs,
 	}
 }
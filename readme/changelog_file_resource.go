@@ -0,0 +1,565 @@
+package readme
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/terraform-provider-readme/readme/keepachangelog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &changelogFileResource{}
+	_ resource.ResourceWithConfigure      = &changelogFileResource{}
+	_ resource.ResourceWithModifyPlan     = &changelogFileResource{}
+	_ resource.ResourceWithValidateConfig = &changelogFileResource{}
+)
+
+// changelogFileDefaultType is the changelog type of releases that don't map to a single type by default.
+const changelogFileDefaultType = "improved"
+
+// changelogSectionTypes maps the Keep a Changelog section names, in lower case, to changelog types.
+var changelogSectionTypes = map[string]string{
+	"added":      "added",
+	"changed":    "improved",
+	"deprecated": "deprecated",
+	"fixed":      "fixed",
+	"removed":    "removed",
+	"security":   "fixed",
+}
+
+// changelogFileReleaseType is the type of the changelogs in the `releases` attribute.
+var changelogFileReleaseType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"body_sha256": types.StringType,
+		"hidden":      types.BoolType,
+		"id":          types.StringType,
+		"slug":        types.StringType,
+		"title":       types.StringType,
+		"type":        types.StringType,
+	},
+}
+
+// changelogFileResource is the resource implementation.
+type changelogFileResource struct {
+	client *readme.Client
+}
+
+// changelogFileResourceModel is the data structure used to hold the resource state.
+type changelogFileResourceModel struct {
+	CombinedType      types.String   `tfsdk:"combined_type"`
+	Hidden            types.Bool     `tfsdk:"hidden"`
+	ID                types.String   `tfsdk:"id"`
+	IncludeUnreleased types.Bool     `tfsdk:"include_unreleased"`
+	Releases          types.Map      `tfsdk:"releases"`
+	SourceFile        types.String   `tfsdk:"source_file"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	TitlePrefix       types.String   `tfsdk:"title_prefix"`
+}
+
+// changelogFileReleaseModel is a changelog in the `releases` attribute.
+type changelogFileReleaseModel struct {
+	BodySHA256 types.String `tfsdk:"body_sha256"`
+	Hidden     types.Bool   `tfsdk:"hidden"`
+	ID         types.String `tfsdk:"id"`
+	Slug       types.String `tfsdk:"slug"`
+	Title      types.String `tfsdk:"title"`
+	Type       types.String `tfsdk:"type"`
+}
+
+// changelogFileRelease is a release in the changelog file and the changelog it's published as.
+type changelogFileRelease struct {
+	// Version is the version of the release.
+	Version string
+	// Params are the parameters of the changelog.
+	Params readme.ChangelogParams
+}
+
+// NewChangelogFileResource is a helper function to simplify the provider implementation.
+func NewChangelogFileResource() resource.Resource {
+	return &changelogFileResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *changelogFileResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_changelog_file"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *changelogFileResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerData).client
+}
+
+// known returns whether every attribute that changes the published changelogs is known.
+func (m changelogFileResourceModel) known() bool {
+	return !m.SourceFile.IsUnknown() && !m.CombinedType.IsUnknown() && !m.Hidden.IsUnknown() &&
+		!m.IncludeUnreleased.IsUnknown() && !m.TitlePrefix.IsUnknown()
+}
+
+// releases reads the changelog file and returns the releases to publish, in the order they appear in the file.
+func (m changelogFileResourceModel) releases() ([]changelogFileRelease, error) {
+	content, err := openFile(m.SourceFile.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	releases := []changelogFileRelease{}
+	versions := map[string]bool{}
+
+	for _, release := range keepachangelog.Parse(string(content)) {
+		if release.IsUnreleased() && !m.IncludeUnreleased.ValueBool() {
+			continue
+		}
+
+		if versions[release.Version] {
+			return nil, fmt.Errorf("the release %s appears more than once in %s",
+				release.Version, m.SourceFile.ValueString())
+		}
+
+		versions[release.Version] = true
+
+		changelogType := m.CombinedType.ValueString()
+		body := release.Body
+
+		// A release with a single section that maps to a type is published as that type without the section
+		// heading. Other releases are published with the combined type and every section in the body.
+		if len(release.Sections) == 1 && strings.HasPrefix(release.Body, "###") {
+			if sectionType, ok := changelogSectionTypes[strings.ToLower(release.Sections[0].Name)]; ok {
+				changelogType = sectionType
+				body = release.Sections[0].Body
+			}
+		}
+
+		releases = append(releases, changelogFileRelease{
+			Version: release.Version,
+			Params: readme.ChangelogParams{
+				Body:   body,
+				Hidden: m.Hidden.ValueBoolPointer(),
+				Title:  m.TitlePrefix.ValueString() + release.Version,
+				Type:   changelogType,
+			},
+		})
+	}
+
+	return releases, nil
+}
+
+// model returns the planned changelog of a release, with an unknown ID and slug.
+func (r changelogFileRelease) model() changelogFileReleaseModel {
+	return changelogFileReleaseModel{
+		BodySHA256: types.StringValue(sha256Hex(strings.TrimSpace(r.Params.Body))),
+		Hidden:     types.BoolPointerValue(r.Params.Hidden),
+		ID:         types.StringUnknown(),
+		Slug:       types.StringUnknown(),
+		Title:      types.StringValue(r.Params.Title),
+		Type:       types.StringValue(r.Params.Type),
+	}
+}
+
+// changed returns whether the published changelog differs from the planned changelog.
+func (m changelogFileReleaseModel) changed(planned changelogFileReleaseModel) bool {
+	return m.BodySHA256 != planned.BodySHA256 || m.Hidden != planned.Hidden || m.Title != planned.Title ||
+		m.Type != planned.Type
+}
+
+// stateReleases returns the published changelogs from the state, keyed by their release versions.
+func stateReleases(ctx context.Context, state *changelogFileResourceModel) map[string]changelogFileReleaseModel {
+	releases := map[string]changelogFileReleaseModel{}
+
+	if state != nil && !state.Releases.IsNull() && !state.Releases.IsUnknown() {
+		state.Releases.ElementsAs(ctx, &releases, false)
+	}
+
+	return releases
+}
+
+// ValidateConfig is used for validating attribute values.
+func (r *changelogFileResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data changelogFileResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CombinedType.IsNull() && !data.CombinedType.IsUnknown() &&
+		!slices.Contains(changelogTypes, data.CombinedType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("combined_type"),
+			"Invalid changelog type.",
+			fmt.Sprintf("combined_type must be one of 'added', 'fixed', 'improved', 'deprecated', or 'removed', "+
+				"got '%s'.", data.CombinedType.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan plans the changelogs of the releases in the changelog file.
+//
+// Releases whose changelogs match the state are not published again, so their values are known at plan time. The
+// slugs of new and changed releases are unknown until they're published.
+func (r *changelogFileResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	plan := &changelogFileResourceModel{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	state := &changelogFileResourceModel{}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+
+	// The ID is the path to the changelog file, which is unknown if the path isn't known until apply.
+	plan.ID = plan.SourceFile
+
+	if !plan.known() {
+		plan.Releases = types.MapUnknown(changelogFileReleaseType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+
+		return
+	}
+
+	releases, err := plan.releases()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_file"), "Unable to read changelog file.", err.Error())
+
+		return
+	}
+
+	priorReleases := stateReleases(ctx, state)
+	values := map[string]attr.Value{}
+
+	for _, release := range releases {
+		model := release.model()
+
+		if prior, ok := priorReleases[release.Version]; ok {
+			if prior.changed(model) {
+				model.ID = prior.ID
+			} else {
+				model = prior
+			}
+		}
+
+		value, diags := types.ObjectValueFrom(ctx, changelogFileReleaseType.AttrTypes, model)
+		resp.Diagnostics.Append(diags...)
+		values[release.Version] = value
+	}
+
+	releasesValue, diags := types.MapValue(changelogFileReleaseType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Releases = releasesValue
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// save publishes the new and changed releases in the changelog file, deletes the changelogs of removed releases,
+// and returns the resource state.
+//
+// Releases are published from the oldest to the newest so that the newest release is listed first on ReadMe. If a
+// changelog fails to publish or delete, its prior value is kept in the state so that it's retried on the next apply,
+// and an error is returned.
+func (r *changelogFileResource) save(
+	ctx context.Context,
+	plan changelogFileResourceModel,
+	state *changelogFileResourceModel,
+) (changelogFileResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorReleases := stateReleases(ctx, state)
+	published := map[string]changelogFileReleaseModel{}
+	plan.ID = plan.SourceFile
+
+	releases, err := plan.releases()
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_file"), "Unable to read changelog file.", err.Error())
+
+		releasesValue, valueDiags := types.MapValueFrom(ctx, changelogFileReleaseType, priorReleases)
+		diags.Append(valueDiags...)
+		plan.Releases = releasesValue
+
+		return plan, diags
+	}
+
+	versions := map[string]bool{}
+	for _, release := range releases {
+		versions[release.Version] = true
+	}
+
+	for version, prior := range priorReleases {
+		if versions[version] {
+			continue
+		}
+
+		_, apiResponse, err := r.client.Changelog.Delete(prior.Slug.ValueString())
+		if err != nil && (apiResponse == nil || apiResponse.HTTPResponse == nil ||
+			apiResponse.HTTPResponse.StatusCode != http.StatusNotFound) {
+			diags.AddError(
+				"Unable to delete changelog.",
+				fmt.Sprintf("Unable to delete the changelog for release %s: %s", version, clientError(err, apiResponse)),
+			)
+
+			published[version] = prior
+		}
+	}
+
+	for i := len(releases) - 1; i >= 0; i-- {
+		release := releases[i]
+		model := release.model()
+
+		prior, ok := priorReleases[release.Version]
+		if ok && !prior.changed(model) {
+			published[release.Version] = prior
+
+			continue
+		}
+
+		var changelog readme.Changelog
+		var apiResponse *readme.APIResponse
+		var err error
+
+		if ok {
+			changelog, apiResponse, err = r.client.Changelog.Update(prior.Slug.ValueString(), release.Params)
+		} else {
+			changelog, apiResponse, err = r.client.Changelog.Create(release.Params)
+		}
+
+		if err != nil {
+			diags.AddError(
+				"Unable to publish changelog.",
+				fmt.Sprintf("Unable to publish the changelog for release %s: %s",
+					release.Version, clientError(err, apiResponse)),
+			)
+
+			if ok {
+				published[release.Version] = prior
+			}
+
+			continue
+		}
+
+		model.ID = types.StringValue(changelog.ID)
+		model.Slug = types.StringValue(changelog.Slug)
+		published[release.Version] = model
+	}
+
+	releasesValue, valueDiags := types.MapValueFrom(ctx, changelogFileReleaseType, published)
+	diags.Append(valueDiags...)
+
+	plan.Releases = releasesValue
+
+	return plan, diags
+}
+
+// Create publishes the releases in the changelog file and sets the initial Terraform state.
+func (r *changelogFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan changelogFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Create, operationCreate, &resp.Diagnostics)
+	defer done()
+
+	state, diags := r.save(ctx, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the published changelogs.
+//
+// Changelogs that no longer exist are removed from the state so that they're published again on the next apply.
+func (r *changelogFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &changelogFileResourceModel{}
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Read, operationRead, &resp.Diagnostics)
+	defer done()
+
+	releases := stateReleases(ctx, state)
+
+	for version, release := range releases {
+		changelog, apiResponse, err := r.client.Changelog.Get(release.Slug.ValueString())
+		if err != nil {
+			if apiResponse != nil && apiResponse.HTTPResponse != nil &&
+				apiResponse.HTTPResponse.StatusCode == http.StatusNotFound {
+				delete(releases, version)
+
+				continue
+			}
+
+			resp.Diagnostics.AddError(
+				"Unable to retrieve changelog.",
+				fmt.Sprintf("Unable to retrieve the changelog for release %s: %s",
+					version, clientError(err, apiResponse)),
+			)
+
+			return
+		}
+
+		releases[version] = changelogFileReleaseModel{
+			BodySHA256: types.StringValue(sha256Hex(strings.TrimSpace(changelog.Body))),
+			Hidden:     types.BoolValue(changelog.Hidden),
+			ID:         types.StringValue(changelog.ID),
+			Slug:       types.StringValue(changelog.Slug),
+			Title:      types.StringValue(changelog.Title),
+			Type:       types.StringValue(changelog.Type),
+		}
+	}
+
+	releasesValue, diags := types.MapValueFrom(ctx, changelogFileReleaseType, releases)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Releases = releasesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update publishes the new and changed releases in the changelog file and updates the Terraform state.
+func (r *changelogFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state changelogFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Update, operationUpdate, &resp.Diagnostics)
+	defer done()
+
+	saved, diags := r.save(ctx, plan, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, saved)...)
+}
+
+// Delete deletes the changelogs of every release and removes the Terraform state on success.
+func (r *changelogFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &changelogFileResourceModel{}
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Delete, operationDelete, &resp.Diagnostics)
+	defer done()
+
+	for version, release := range stateReleases(ctx, state) {
+		_, apiResponse, err := r.client.Changelog.Delete(release.Slug.ValueString())
+		if err != nil && (apiResponse == nil || apiResponse.HTTPResponse == nil ||
+			apiResponse.HTTPResponse.StatusCode != http.StatusNotFound) {
+			resp.Diagnostics.AddError(
+				"Unable to delete changelog.",
+				fmt.Sprintf("Unable to delete the changelog for release %s: %s", version, clientError(err, apiResponse)),
+			)
+		}
+	}
+}
+
+// Schema defines the changelog file resource attributes.
+func (r *changelogFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the changelogs of the releases in a changelog file on ReadMe.com\n\n" +
+			"The changelog file is parsed in the [Keep a Changelog](https://keepachangelog.com) format and each " +
+			"release heading, such as `## [1.2.0] - 2024-01-02`, is published as a changelog. A release with a " +
+			"single section is published with the changelog type of the section and the body of the section: " +
+			"`Added` is published as `added`, `Changed` as `improved`, `Deprecated` as `deprecated`, `Fixed` " +
+			"and `Security` as `fixed`, and `Removed` as `removed`. Other releases are published with " +
+			"`combined_type` and a body that includes every section.\n\n" +
+			"Only the changelogs of new and changed releases are published on each apply. The changelogs of " +
+			"releases that are removed from the file are deleted.\n\n" +
+			"See <https://docs.readme.com/main/reference/createchangelog> for more information about this API " +
+			"endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"combined_type": schema.StringAttribute{
+				Description: "The changelog type of releases that don't have a single section that maps to a " +
+					"changelog type. Must be one of `added`, `fixed`, `improved`, `deprecated`, or `removed`. " +
+					"Defaults to `" + changelogFileDefaultType + "`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(changelogFileDefaultType),
+			},
+			"hidden": schema.BoolAttribute{
+				Description: "Whether the changelogs are hidden. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"id": schema.StringAttribute{
+				Description: "The path to the changelog file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"include_unreleased": schema.BoolAttribute{
+				Description: "Whether to publish the `[Unreleased]` release. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"releases": schema.MapAttribute{
+				Description: "A map of the release versions to their published changelog `id`, `slug`, `title`, " +
+					"`type`, `hidden` status, and the `body_sha256` checksum of the body.",
+				Computed:    true,
+				ElementType: changelogFileReleaseType,
+			},
+			"source_file": schema.StringAttribute{
+				Description: "The path to the local changelog file.",
+				Required:    true,
+			},
+			"title_prefix": schema.StringAttribute{
+				Description: "A prefix for the changelog titles, which are the release versions, such as `v`. " +
+					"Defaults to an empty string.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
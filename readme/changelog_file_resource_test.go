@@ -0,0 +1,248 @@
+// nolint:goconst // Intentional repetition of some values for tests.
+package readme
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"gopkg.in/h2non/gock.v1"
+)
+
+// changelogFileGocks mocks publishing and retrieving the changelog of a release. The changelog's slug is the
+// release version without dots.
+func changelogFileGocks(method, version, changelogType, body string) {
+	slug := regexp.MustCompile(`\.`).ReplaceAllString(version, "")
+	changelog := readme.Changelog{
+		Body:   body,
+		Hidden: true,
+		ID:     "id-" + slug,
+		Slug:   slug,
+		Title:  version,
+		Type:   changelogType,
+	}
+
+	request := gock.New(testURL)
+	switch method {
+	case "POST":
+		request = request.Post("/changelogs")
+	case "PUT":
+		request = request.Put("/changelogs/" + slug)
+	}
+
+	request.
+		BodyString(fmt.Sprintf(`"title":"%s","type":"%s"`, regexp.QuoteMeta(version), changelogType)).
+		Times(1).
+		Reply(map[string]int{"POST": 201, "PUT": 200}[method]).
+		JSON(changelog)
+
+	gock.New(testURL).Get("/changelogs/" + slug).Persist().Reply(200).JSON(changelog)
+}
+
+func TestChangelogFileResource(t *testing.T) {
+	sourceFile := t.TempDir() + "/CHANGELOG.md"
+	movedFile := t.TempDir() + "/CHANGES.md"
+	writeChangelog := func(content string) {
+		if err := os.WriteFile(sourceFile, []byte(content), 0o600); err != nil {
+			t.Fatalf("unable to write changelog file: %s", err)
+		}
+	}
+
+	config := providerConfig + fmt.Sprintf(`
+		resource "readme_changelog_file" "test" {
+			source_file = "%s"
+		}
+	`, sourceFile)
+
+	// Close all gocks when completed.
+	defer gock.OffAll()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test publishing each release except the unreleased changes.
+			{
+				PreConfig: func() {
+					gock.OffAll()
+					writeChangelog("# Changelog\n\n" +
+						"## [Unreleased]\n\n### Added\n\n- Frogs.\n\n" +
+						"## [1.1.0] - 2024-02-01\n\n### Added\n\n- Tortoises.\n\n### Fixed\n\n- Shells.\n\n" +
+						"## [1.0.0] - 2024-01-01\n\n### Added\n\n- Turtles.\n")
+
+					changelogFileGocks("POST", "1.0.0", "added", "- Turtles.")
+					changelogFileGocks("POST", "1.1.0", "improved",
+						"### Added\n\n- Tortoises.\n\n### Fixed\n\n- Shells.")
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "id", sourceFile),
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "releases.%", "2"),
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "releases.1.0.0.slug", "100"),
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "releases.1.0.0.type", "added"),
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "releases.1.1.0.id", "id-110"),
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "releases.1.1.0.type", "improved"),
+					resource.TestCheckResourceAttr(
+						"readme_changelog_file.test",
+						"releases.1.1.0.body_sha256",
+						sha256Hex("### Added\n\n- Tortoises.\n\n### Fixed\n\n- Shells."),
+					),
+				),
+			},
+			// Test that a changed release is updated, a new release is created, and a removed release is deleted.
+			{
+				PreConfig: func() {
+					gock.OffAll()
+					writeChangelog("# Changelog\n\n" +
+						"## [1.2.0] - 2024-03-01\n\n### Removed\n\n- Frogs.\n\n" +
+						"## [1.1.0] - 2024-02-01\n\n### Added\n\n- Tortoises.\n\n### Fixed\n\n- Shells and scales.\n")
+
+					changelogFileGocks("POST", "1.2.0", "removed", "- Frogs.")
+					changelogFileGocks("PUT", "1.1.0", "improved",
+						"### Added\n\n- Tortoises.\n\n### Fixed\n\n- Shells and scales.")
+					gock.New(testURL).Delete("/changelogs/100").Times(1).Reply(204)
+					gock.New(testURL).Get("/changelogs/100").Persist().Reply(404).JSON(map[string]string{})
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "releases.%", "2"),
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "releases.1.2.0.slug", "120"),
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "releases.1.2.0.type", "removed"),
+					resource.TestCheckResourceAttr(
+						"readme_changelog_file.test",
+						"releases.1.1.0.body_sha256",
+						sha256Hex("### Added\n\n- Tortoises.\n\n### Fixed\n\n- Shells and scales."),
+					),
+				),
+			},
+			// Test that a changelog deleted outside of Terraform is published again.
+			{
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).Get("/changelogs/110").Persist().Reply(404).JSON(map[string]string{})
+					gock.New(testURL).Get("/changelogs/120").Persist().Reply(200).JSON(readme.Changelog{
+						Body: "- Frogs.", Hidden: true, ID: "id-120", Slug: "120", Title: "1.2.0", Type: "removed",
+					})
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test including the unreleased changes with a title prefix.
+			{
+				PreConfig: func() {
+					gock.OffAll()
+					writeChangelog("## [Unreleased]\n\n### Changed\n\n- Turtles.\n")
+
+					gock.New(testURL).Get("/changelogs/110").Persist().Reply(404).JSON(map[string]string{})
+					gock.New(testURL).Get("/changelogs/120").Persist().Reply(200).JSON(readme.Changelog{
+						Body: "- Frogs.", Hidden: true, ID: "id-120", Slug: "120", Title: "1.2.0", Type: "removed",
+					})
+					gock.New(testURL).Delete("/changelogs/120").Times(1).Reply(204)
+
+					changelog := readme.Changelog{
+						Body: "- Turtles.", ID: "id-next", Slug: "next", Title: "Next Unreleased", Type: "improved",
+					}
+					gock.New(testURL).
+						Post("/changelogs").
+						BodyString(`"hidden":false,"title":"Next Unreleased","type":"improved"`).
+						Times(1).
+						Reply(201).
+						JSON(changelog)
+					gock.New(testURL).Get("/changelogs/next").Persist().Reply(200).JSON(changelog)
+					gock.New(testURL).Delete("/changelogs/next").Times(1).Reply(204)
+				},
+				Config: providerConfig + fmt.Sprintf(`
+					resource "readme_changelog_file" "test" {
+						source_file        = "%s"
+						include_unreleased = true
+						hidden             = false
+						title_prefix       = "Next "
+					}
+				`, sourceFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "releases.%", "1"),
+					resource.TestCheckResourceAttr(
+						"readme_changelog_file.test",
+						"releases.Unreleased.title",
+						"Next Unreleased",
+					),
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "releases.Unreleased.type", "improved"),
+				),
+			},
+			// Test that moving the changelog file changes the ID without publishing the unchanged release again.
+			{
+				PreConfig: func() {
+					gock.OffAll()
+					content := []byte("## [Unreleased]\n\n### Changed\n\n- Turtles.\n")
+					if err := os.WriteFile(movedFile, content, 0o600); err != nil {
+						t.Fatalf("unable to write changelog file: %s", err)
+					}
+
+					changelog := readme.Changelog{
+						Body: "- Turtles.", ID: "id-next", Slug: "next", Title: "Next Unreleased", Type: "improved",
+					}
+					gock.New(testURL).Get("/changelogs/next").Persist().Reply(200).JSON(changelog)
+					gock.New(testURL).Delete("/changelogs/next").Times(1).Reply(204)
+				},
+				Config: providerConfig + fmt.Sprintf(`
+					resource "readme_changelog_file" "test" {
+						source_file        = "%s"
+						include_unreleased = true
+						hidden             = false
+						title_prefix       = "Next "
+					}
+				`, movedFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "id", movedFile),
+					resource.TestCheckResourceAttr("readme_changelog_file.test", "releases.Unreleased.id", "id-next"),
+				),
+			},
+		},
+	})
+}
+
+func TestChangelogFileResource_Errors(t *testing.T) {
+	sourceFile := t.TempDir() + "/CHANGELOG.md"
+	if err := os.WriteFile(sourceFile, []byte("## [1.0.0]\n\nTurtles.\n\n## [1.0.0]\n\nTortoises.\n"), 0o600); err != nil {
+		t.Fatalf("unable to write changelog file: %s", err)
+	}
+
+	testCases := []struct {
+		config string
+		error  string
+	}{
+		{
+			config: `source_file = "` + sourceFile + `"` + "\ncombined_type = \"changed\"",
+			error:  "combined_type must be one of 'added', 'fixed', 'improved', 'deprecated', or",
+		},
+		{
+			config: `source_file = "` + sourceFile + `"`,
+			error:  `the release 1.0.0 appears more than once`,
+		},
+		{
+			config: `source_file = "does-not-exist.md"`,
+			error:  "Unable to read changelog file",
+		},
+	}
+
+	for _, testCase := range testCases {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + fmt.Sprintf(`
+						resource "readme_changelog_file" "test" {
+							%s
+						}
+					`, testCase.config),
+					ExpectError: regexp.MustCompile(testCase.error),
+				},
+			},
+		})
+	}
+}
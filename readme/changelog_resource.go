@@ -2,10 +2,14 @@ package readme
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,45 +29,105 @@ var (
 )
 
 // changelogResource is the data source implementation.
+// changelogFrontMatterKeys maps the front matter keys supported by the readme_changelog resource to the attributes
+// they set.
+var changelogFrontMatterKeys = map[string]string{
+	"body":   "body",
+	"hidden": "hidden",
+	"title":  "title",
+	"type":   "type",
+}
+
 type changelogResource struct {
-	client *readme.Client
+	client          *readme.Client
+	frontMatterMode string
 }
 
 // changelogResourceModel is the resource model used by the readme_changelog resource.
 type changelogResourceModel struct {
-	Algolia   types.Object `tfsdk:"algolia"`
-	Body      types.String `tfsdk:"body"`
-	BodyClean types.String `tfsdk:"body_clean"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	HTML      types.String `tfsdk:"html"`
-	Hidden    types.Bool   `tfsdk:"hidden"`
-	ID        types.String `tfsdk:"id"`
-	Metadata  types.Object `tfsdk:"metadata"`
-	Revision  types.Int64  `tfsdk:"revision"`
-	Slug      types.String `tfsdk:"slug"`
-	Title     types.String `tfsdk:"title"`
-	Type      types.String `tfsdk:"type"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	Algolia            types.Object   `tfsdk:"algolia"`
+	Body               types.String   `tfsdk:"body"`
+	BodyClean          types.String   `tfsdk:"body_clean"`
+	BodyFormat         types.String   `tfsdk:"body_format"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	FrontMatterFormat  types.String   `tfsdk:"front_matter_format"`
+	FrontMatterMode    types.String   `tfsdk:"front_matter_mode"`
+	HTML               types.String   `tfsdk:"html"`
+	Hidden             types.Bool     `tfsdk:"hidden"`
+	ID                 types.String   `tfsdk:"id"`
+	ImageBaseDir       types.String   `tfsdk:"image_base_dir"`
+	Metadata           types.Object   `tfsdk:"metadata"`
+	Revision           types.Int64    `tfsdk:"revision"`
+	SearchIndexTimeout types.String   `tfsdk:"search_index_timeout"`
+	Slug               types.String   `tfsdk:"slug"`
+	SourceFile         types.String   `tfsdk:"source_file"`
+	SourceSHA256       types.String   `tfsdk:"source_sha256"`
+	StoreBody          types.Bool     `tfsdk:"store_body"`
+	TemplateVars       types.Map      `tfsdk:"template_vars"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Title              types.String   `tfsdk:"title"`
+	Type               types.String   `tfsdk:"type"`
+	UpdatedAt          types.String   `tfsdk:"updated_at"`
+	UploadImages       types.Bool     `tfsdk:"upload_images"`
+	UploadedImages     types.Map      `tfsdk:"uploaded_images"`
+	WaitForSearchIndex types.Bool     `tfsdk:"wait_for_search_index"`
 }
 
 // changelogResourceMapToModel maps a readme.Changelog to a changelogResourceModel
 // for use in the readme_custom_page resource.
 func changelogResourceMapToModel(changelog readme.Changelog, plan changelogResourceModel) changelogResourceModel {
-	return changelogResourceModel{
-		Algolia:   docModelAlgoliaValue(changelog.Algolia),
-		Body:      plan.Body,
-		BodyClean: types.StringValue(changelog.Body),
-		CreatedAt: types.StringValue(changelog.CreatedAt),
-		HTML:      types.StringValue(changelog.HTML),
-		Hidden:    types.BoolValue(changelog.Hidden),
-		ID:        types.StringValue(changelog.ID),
-		Metadata:  docModelMetadataValue(changelog.Metadata),
-		Revision:  types.Int64Value(int64(changelog.Revision)),
-		Slug:      types.StringValue(changelog.Slug),
-		Title:     types.StringValue(changelog.Title),
-		Type:      types.StringValue(changelog.Type),
-		UpdatedAt: types.StringValue(changelog.UpdatedAt),
+	if plan.StoreBody.IsNull() || plan.StoreBody.IsUnknown() {
+		plan.StoreBody = types.BoolValue(true)
+	}
+
+	if plan.UploadImages.IsNull() || plan.UploadImages.IsUnknown() {
+		plan.UploadImages = types.BoolValue(false)
+	}
+
+	if plan.UploadedImages.IsNull() || plan.UploadedImages.IsUnknown() {
+		plan.UploadedImages = types.MapNull(types.StringType)
+	}
+
+	if plan.TemplateVars.IsNull() || plan.TemplateVars.IsUnknown() {
+		plan.TemplateVars = types.MapNull(types.StringType)
+	}
+
+	model := changelogResourceModel{
+		Algolia:            docModelAlgoliaValue(changelog.Algolia),
+		Body:               plan.Body,
+		BodyClean:          types.StringValue(changelog.Body),
+		BodyFormat:         bodyFormatValue(plan.BodyFormat),
+		CreatedAt:          types.StringValue(changelog.CreatedAt),
+		FrontMatterFormat:  plan.FrontMatterFormat,
+		FrontMatterMode:    plan.FrontMatterMode,
+		HTML:               types.StringValue(changelog.HTML),
+		Hidden:             types.BoolValue(changelog.Hidden),
+		ID:                 types.StringValue(changelog.ID),
+		ImageBaseDir:       plan.ImageBaseDir,
+		Metadata:           docModelMetadataValue(changelog.Metadata),
+		Revision:           types.Int64Value(int64(changelog.Revision)),
+		SearchIndexTimeout: plan.SearchIndexTimeout,
+		Slug:               types.StringValue(changelog.Slug),
+		SourceFile:         plan.SourceFile,
+		SourceSHA256:       plan.SourceSHA256,
+		StoreBody:          plan.StoreBody,
+		TemplateVars:       plan.TemplateVars,
+		Timeouts:           plan.Timeouts,
+		Title:              types.StringValue(changelog.Title),
+		Type:               types.StringValue(changelog.Type),
+		UpdatedAt:          types.StringValue(changelog.UpdatedAt),
+		UploadImages:       plan.UploadImages,
+		UploadedImages:     plan.UploadedImages,
+		WaitForSearchIndex: plan.WaitForSearchIndex,
+	}
+
+	// Only store a checksum of the body when the body isn't stored.
+	if storeBodyDisabled(plan.SourceFile, plan.StoreBody) {
+		model.BodyClean = types.StringValue(bodyHash(changelog.Body))
+		model.HTML = types.StringNull()
 	}
+
+	return model
 }
 
 // NewChangelogResource is a helper function to simplify the provider implementation.
@@ -82,7 +146,14 @@ func (r *changelogResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = req.ProviderData.(*readme.Client)
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.frontMatterMode = data.frontMatterMode
+}
+
+// frontMatterSettings returns the front matter settings for a changelog plan.
+func (r *changelogResource) frontMatterSettings(plan changelogResourceModel) frontMatterSettings {
+	return newFrontMatterSettings(plan.FrontMatterFormat, plan.FrontMatterMode, types.BoolNull(), r.frontMatterMode)
 }
 
 // ModifyPlan is used for modifying the plan before it is applied. In particular,
@@ -95,28 +166,106 @@ func (r *changelogResource) ModifyPlan(
 	plan := &changelogResourceModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	state := &changelogResourceModel{}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
 	if resp.Diagnostics.HasError() || plan == nil {
 		return
 	}
 
+	// Read the body from the source file if one is set.
+	var stateBody, stateSHA256 types.String
+	if state != nil {
+		stateBody, stateSHA256 = state.Body, state.SourceSHA256
+	}
+
+	source, diags := planSourceFile(plan.Body, plan.SourceFile, plan.StoreBody, stateBody, stateSHA256)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Body = source.Body
+	plan.SourceSHA256 = source.SHA256
+
+	// Render the body as a template.
+	content, rendered, diags := planTemplate(ctx, source.Content, plan.TemplateVars, plan.SourceFile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate the front matter keys.
+	if rendered {
+		var config changelogResourceModel
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		resp.Diagnostics.Append(checkFrontMatter(
+			content,
+			r.frontMatterSettings(*plan),
+			plan.SourceFile,
+			changelogFrontMatterKeys,
+			configuredAttributes(map[string]attr.Value{
+				"hidden": config.Hidden,
+				"title":  config.Title,
+				"type":   config.Type,
+			}),
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Expand the include directives in the body.
+	content, diags = planIncludes(content, plan.SourceFile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Rewrite references to local images that have already been uploaded.
+	var stateUploaded types.Map
+	if state != nil {
+		stateUploaded = state.UploadedImages
+	}
+
+	images, diags := planImages(
+		ctx,
+		formatBody(content, plan.BodyFormat),
+		plan.UploadImages,
+		imageBaseDir(plan.ImageBaseDir, plan.SourceFile),
+		stateUploaded,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.UploadedImages = images.Uploaded
+
 	// Trim leading and trailing whitespace from the body.
 	// The ReadMe API normalizes this, but we need to track the original value
 	// provided by the user.
 	// The 'body_clean' attribute is used to track the normalized value to
-	// compare against the API response.
-	body := strings.TrimSpace(plan.Body.ValueString())
+	// compare against the API response, which doesn't include the front
+	// matter.
+	body := strings.TrimSpace(frontmatter.Strip(images.Body, plan.FrontMatterFormat.ValueString()))
 	plan.BodyClean = types.StringValue(body)
 
+	if storeBodyDisabled(plan.SourceFile, plan.StoreBody) {
+		plan.BodyClean = types.StringValue(bodyHash(body))
+	}
+
+	if plan.SourceFile.IsUnknown() || !images.Known || !rendered {
+		plan.BodyClean = types.StringUnknown()
+	}
+
 	if plan.Hidden.IsNull() {
 		plan.Hidden = types.BoolValue(true)
 	}
 
-	diags := resp.Plan.Set(ctx, plan)
+	diags = resp.Plan.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 
-	state := &changelogResourceModel{}
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-
 	if state == nil {
 		return
 	}
@@ -133,7 +282,9 @@ func (r *changelogResource) ModifyPlan(
 			"publish_pending": types.BoolType,
 			"updated_at":      types.StringType,
 		})
-		plan.HTML = types.StringUnknown()
+		if !storeBodyDisabled(plan.SourceFile, plan.StoreBody) {
+			plan.HTML = types.StringUnknown()
+		}
 		plan.Revision = types.Int64Unknown()
 		plan.UpdatedAt = types.StringUnknown()
 		plan.Metadata = types.ObjectUnknown(map[string]attr.Type{
@@ -157,10 +308,36 @@ func (r changelogResource) ValidateConfig(
 ) {
 	var data changelogResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	resp.Diagnostics.Append(validateBodyFormat(data.BodyFormat)...)
+	resp.Diagnostics.Append(validateFrontMatterFormat(data.FrontMatterFormat)...)
+	resp.Diagnostics.Append(validateFrontMatterMode(data.FrontMatterMode)...)
+	resp.Diagnostics.Append(validateSearchIndexTimeout(data.SearchIndexTimeout)...)
+
+	if data.Body.IsNull() && data.SourceFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("body"),
+			"Missing required attribute.",
+			"One of 'body' or 'source_file' must be set.",
+		)
+
+		return
+	}
+
+	body, diags := configBody(data.Body, data.SourceFile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Front matter can't be checked until the template variables are known.
+	vars, known := templateVars(ctx, data.TemplateVars)
+	if !known {
+		return
+	}
 
 	if data.Title.IsNull() {
 		// check front matter for 'title'.
-		titleMatter, diag := frontmatter.GetValue(ctx, data.Body.ValueString(), "Title")
+		titleMatter, diag := frontmatter.GetValue(ctx, body, "Title", vars, data.FrontMatterFormat.ValueString())
 		if diag != "" {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("title"),
@@ -185,7 +362,7 @@ func (r changelogResource) ValidateConfig(
 
 	if data.Type.IsNull() {
 		// check front matter for 'type'.
-		typeMatter, diag := frontmatter.GetValue(ctx, data.Body.ValueString(), "Type")
+		typeMatter, diag := frontmatter.GetValue(ctx, body, "Type", vars, data.FrontMatterFormat.ValueString())
 		if diag != "" {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("type"),
@@ -209,6 +386,29 @@ func (r changelogResource) ValidateConfig(
 	}
 }
 
+// waitForSearchIndex waits for a saved changelog to be indexed for search when `wait_for_search_index` is true.
+func (r *changelogResource) waitForSearchIndex(
+	ctx context.Context,
+	state changelogResourceModel,
+) (changelogResourceModel, diag.Diagnostics) {
+	return waitForSearchIndex(
+		ctx,
+		state.WaitForSearchIndex,
+		state.SearchIndexTimeout,
+		fmt.Sprintf("changelog '%s'", state.Slug.ValueString()),
+		state,
+		func(model changelogResourceModel) types.Object { return model.Algolia },
+		func() (changelogResourceModel, error) {
+			changelog, apiResponse, err := r.client.Changelog.Get(state.Slug.ValueString())
+			if err != nil {
+				return state, errors.New(clientError(err, apiResponse))
+			}
+
+			return changelogResourceMapToModel(changelog, state), nil
+		},
+	)
+}
+
 // Create creates the changelog and sets the initial Terraform state.
 func (r *changelogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan changelogResourceModel
@@ -218,14 +418,51 @@ func (r *changelogResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Create, operationCreate, &resp.Diagnostics)
+	defer done()
+
 	hidden := plan.Hidden.ValueBoolPointer()
 	if hidden == nil {
 		hidden = boolPoint(true)
 	}
 
+	body, err := sourceFileBody(plan.Body, plan.SourceFile, plan.SourceSHA256)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create changelog.", err.Error())
+
+		return
+	}
+
+	body, err = renderBody(ctx, body, plan.TemplateVars)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create changelog.", err.Error())
+
+		return
+	}
+
+	body, err = expandIncludes(body, includeBaseDir(plan.SourceFile))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create changelog.", err.Error())
+
+		return
+	}
+
+	body = formatBody(body, plan.BodyFormat)
+
+	if plan.UploadImages.ValueBool() {
+		baseDir := imageBaseDir(plan.ImageBaseDir, plan.SourceFile)
+		body, plan.UploadedImages, err = uploadImages(ctx, r.client, body, baseDir, types.MapNull(types.StringType))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to create changelog.", err.Error())
+
+			return
+		}
+	}
+
+	// The front matter is only used to set attributes and isn't published.
 	params := readme.ChangelogParams{
 		Title:  plan.Title.ValueString(),
-		Body:   plan.Body.ValueString(),
+		Body:   frontmatter.Strip(body, plan.FrontMatterFormat.ValueString()),
 		Hidden: hidden,
 		Type:   plan.Type.ValueString(),
 	}
@@ -245,7 +482,8 @@ func (r *changelogResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	state := changelogResourceMapToModel(changelog, plan)
+	state, diags := r.waitForSearchIndex(ctx, changelogResourceMapToModel(changelog, plan))
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -259,6 +497,9 @@ func (r *changelogResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Read, operationRead, &resp.Diagnostics)
+	defer done()
+
 	changelog, _, err := r.client.Changelog.Get(state.Slug.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to retrieve changelog.", err.Error())
@@ -281,19 +522,56 @@ func (r *changelogResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Update, operationUpdate, &resp.Diagnostics)
+	defer done()
+
 	hidden := plan.Hidden.ValueBoolPointer()
 	if hidden == nil {
 		hidden = boolPoint(true)
 	}
 
+	body, err := sourceFileBody(plan.Body, plan.SourceFile, plan.SourceSHA256)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update changelog.", err.Error())
+
+		return
+	}
+
+	body, err = renderBody(ctx, body, plan.TemplateVars)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update changelog.", err.Error())
+
+		return
+	}
+
+	body, err = expandIncludes(body, includeBaseDir(plan.SourceFile))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update changelog.", err.Error())
+
+		return
+	}
+
+	body = formatBody(body, plan.BodyFormat)
+
+	if plan.UploadImages.ValueBool() {
+		baseDir := imageBaseDir(plan.ImageBaseDir, plan.SourceFile)
+		body, plan.UploadedImages, err = uploadImages(ctx, r.client, body, baseDir, state.UploadedImages)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to update changelog.", err.Error())
+
+			return
+		}
+	}
+
+	// The front matter is only used to set attributes and isn't published.
 	params := readme.ChangelogParams{
 		Title:  plan.Title.ValueString(),
-		Body:   plan.Body.ValueString(),
+		Body:   frontmatter.Strip(body, plan.FrontMatterFormat.ValueString()),
 		Hidden: hidden,
 		Type:   plan.Type.ValueString(),
 	}
 
-	_, _, err := r.client.Changelog.Update(state.Slug.ValueString(), params)
+	_, _, err = r.client.Changelog.Update(state.Slug.ValueString(), params)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update changelog.", err.Error())
 
@@ -308,9 +586,10 @@ func (r *changelogResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	state = changelogResourceMapToModel(changelog, plan)
+	state, diags := r.waitForSearchIndex(ctx, changelogResourceMapToModel(changelog, plan))
+	resp.Diagnostics.Append(diags...)
 
-	diags := resp.State.Set(ctx, state)
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -322,6 +601,9 @@ func (r *changelogResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	_, done := withTimeout(ctx, &r.client, state.Timeouts.Delete, operationDelete, &resp.Diagnostics)
+	defer done()
+
 	_, apiResponse, err := r.client.Changelog.Delete(state.Slug.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to delete changelog", clientError(err, apiResponse))
@@ -338,12 +620,13 @@ func (r *changelogResource) ImportState(
 }
 
 // Schema for the readme_changelog resource.
-func (r *changelogResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *changelogResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// nolint:goconst
 		Description: "Manage changelogs on ReadMe.com\n\n" +
 			"Changelogs on ReadMe support setting some attributes using front matter. " +
-			"Resource attributes take precedence over front matter attributes in the provider.\n\n" +
+			"Resource attributes take precedence over front matter attributes in the provider. The front matter is " +
+			"removed from the body that is published.\n\n" +
 			"Refer to <https://docs.readme.com/main/docs/rdme> for more information about using front matter in " +
 			"ReadMe docs and changelogs.\n\n" +
 			"See <https://docs.readme.com/main/reference/createchangelog> for more information about this API endpoint.",
@@ -365,8 +648,10 @@ func (r *changelogResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"body": schema.StringAttribute{
-				Description: "The body of the changelog. Optionally use front matter to set certain attributes. ",
-				Required:    true,
+				Description: "The body of the changelog. Optionally use front matter to set certain attributes. " +
+					"One of `body` or `source_file` must be set." + includeDescription,
+				Computed: true,
+				Optional: true,
 			},
 			"body_clean": schema.StringAttribute{
 				Description: "The body of the changelog after normalization.",
@@ -438,5 +723,32 @@ func (r *changelogResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+
+	for name, attribute := range sourceFileSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range uploadImagesSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range bodyFormatSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range templateVarsSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range searchIndexSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range frontMatterSchema() {
+		resp.Schema.Attributes[name] = attribute
 	}
 }
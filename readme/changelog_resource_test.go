@@ -3,10 +3,13 @@ package readme
 
 import (
 	"fmt"
+	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
 	"gopkg.in/h2non/gock.v1"
 )
 
@@ -21,6 +24,10 @@ func TestChangelogResource(t *testing.T) {
 		mockUpdatedChangelog.Title,
 	) + mockUpdatedChangelog.Body
 
+	// The front matter isn't published with the body.
+	publishedChangelog := mockUpdatedChangelog
+	publishedChangelog.Body = mockChangelogs[0].Body
+
 	resource.Test(t, resource.TestCase{
 		IsUnitTest:               true,
 		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
@@ -101,12 +108,12 @@ func TestChangelogResource(t *testing.T) {
 						Put("/changelogs").
 						Times(1).
 						Reply(200).
-						JSON(mockUpdatedChangelog)
+						JSON(publishedChangelog)
 					gock.New(testURL).
 						Get("/changelogs/" + mockChangelogs[0].Slug).
 						Times(3).
 						Reply(200).
-						JSON(mockUpdatedChangelog)
+						JSON(publishedChangelog)
 					gock.New(testURL).
 						Delete("/changelogs/" + mockChangelogs[0].Slug).
 						Times(1).
@@ -153,7 +160,7 @@ func TestChangelogResource(t *testing.T) {
 						Put("/changelogs").
 						Times(1).
 						Reply(200).
-						JSON(mockUpdatedChangelog)
+						JSON(publishedChangelog)
 					gock.New(testURL).
 						Get("/changelogs/" + mockChangelogs[0].Slug).
 						Times(1).
@@ -163,7 +170,7 @@ func TestChangelogResource(t *testing.T) {
 						Get("/changelogs/" + mockChangelogs[0].Slug).
 						Times(2).
 						Reply(200).
-						JSON(mockUpdatedChangelog)
+						JSON(publishedChangelog)
 					gock.New(testURL).
 						Delete("/changelogs/" + mockChangelogs[0].Slug).
 						Times(1).
@@ -206,3 +213,323 @@ func TestChangelogResource(t *testing.T) {
 		},
 	})
 }
+
+func TestChangelogResource_SourceFile(t *testing.T) {
+	// Close all gocks when completed.
+	defer gock.OffAll()
+
+	sourceFile := t.TempDir() + "/changelog.md"
+	if err := os.WriteFile(sourceFile, []byte(mockChangelogs[0].Body+"\n"), 0o600); err != nil {
+		t.Fatalf("unable to write source file: %s", err)
+	}
+
+	config := func(storeBody bool) string {
+		return providerConfig + fmt.Sprintf(`
+			resource "readme_changelog" "test" {
+				title       = "%s"
+				type        = "%s"
+				source_file = "%s"
+				store_body  = %t
+			}`, mockChangelogs[0].Title, mockChangelogs[0].Type, sourceFile, storeBody)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that body and source_file can't both be set.
+			{
+				Config: providerConfig + `
+					resource "readme_changelog" "test" {
+						title       = "` + mockChangelogs[0].Title + `"
+						type        = "` + mockChangelogs[0].Type + `"
+						body        = "` + mockChangelogs[0].Body + `"
+						source_file = "` + sourceFile + `"
+					}`,
+				ExpectError: regexp.MustCompile("Only one of 'body' or 'source_file' may be set."),
+			},
+			// Test creating with the body read from the source file.
+			{
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Post("/changelogs").
+						MatchType("json").
+						JSON(map[string]any{
+							"title":  mockChangelogs[0].Title,
+							"type":   mockChangelogs[0].Type,
+							"body":   mockChangelogs[0].Body + "\n",
+							"hidden": true,
+						}).
+						Times(1).
+						Reply(201).
+						JSON(mockChangelogs[0])
+					gock.New(testURL).
+						Get("/changelogs/" + mockChangelogs[0].Slug).
+						Persist().
+						Reply(200).
+						JSON(mockChangelogs[0])
+				},
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"readme_changelog.test",
+						"body",
+						mockChangelogs[0].Body+"\n",
+					),
+					resource.TestCheckResourceAttr(
+						"readme_changelog.test",
+						"source_sha256",
+						sha256Hex(mockChangelogs[0].Body+"\n"),
+					),
+				),
+			},
+			// Test storing a checksum in place of the body.
+			{
+				Config: config(false),
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Put("/changelogs/" + mockChangelogs[0].Slug).
+						Times(1).
+						Reply(200).
+						JSON(mockChangelogs[0])
+					gock.New(testURL).
+						Get("/changelogs/" + mockChangelogs[0].Slug).
+						Persist().
+						Reply(200).
+						JSON(mockChangelogs[0])
+					gock.New(testURL).
+						Delete("/changelogs/" + mockChangelogs[0].Slug).
+						Times(1).
+						Reply(204)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("readme_changelog.test", "body"),
+					resource.TestCheckNoResourceAttr("readme_changelog.test", "html"),
+					resource.TestCheckResourceAttr(
+						"readme_changelog.test",
+						"body_clean",
+						bodyHash(mockChangelogs[0].Body),
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestChangelogResource_UploadImages(t *testing.T) {
+	// Close all gocks when completed.
+	defer gock.OffAll()
+
+	dir := t.TempDir()
+	image, err := os.ReadFile("../examples/resources/readme_image/example.png")
+	if err != nil {
+		t.Fatalf("unable to read image: %s", err)
+	}
+
+	if err := os.WriteFile(dir+"/example.png", image, 0o600); err != nil {
+		t.Fatalf("unable to write image: %s", err)
+	}
+
+	// changedImage has different content but is still detected as a PNG.
+	changedImage := append(append([]byte{}, image...), 0)
+
+	body := "Added a diagram.\n\n![diagram](example.png)"
+	uploaded := func(url string) readme.Changelog {
+		changelog := mockChangelogs[0]
+		changelog.Body = strings.ReplaceAll(body, "example.png)", url+")")
+
+		return changelog
+	}
+
+	config := providerConfig + `
+		resource "readme_changelog" "test" {
+			title          = "` + mockChangelogs[0].Title + `"
+			type           = "` + mockChangelogs[0].Type + `"
+			body           = "` + escapeNewlines(body) + `"
+			upload_images  = true
+			image_base_dir = "` + dir + `"
+		}`
+
+	mockUpload := func(url string) {
+		gock.New("https://dash.readme.com/api/images").
+			Post("/image-upload").
+			Times(1).
+			Reply(200).
+			JSON([]any{url, "example.png", 1, 1, "#000000"})
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test creating with a local image.
+			{
+				Config: config,
+				PreConfig: func() {
+					gock.OffAll()
+					mockUpload("https://files.readme.io/1-example.png")
+					gock.New(testURL).
+						Post("/changelogs").
+						Times(1).
+						Reply(201).
+						JSON(uploaded("https://files.readme.io/1-example.png"))
+					gock.New(testURL).
+						Get("/changelogs/" + mockChangelogs[0].Slug).
+						Persist().
+						Reply(200).
+						JSON(uploaded("https://files.readme.io/1-example.png"))
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"readme_changelog.test",
+						"body_clean",
+						uploaded("https://files.readme.io/1-example.png").Body,
+					),
+					resource.TestCheckResourceAttr(
+						"readme_changelog.test",
+						"uploaded_images."+sha256Sum(image),
+						"https://files.readme.io/1-example.png",
+					),
+				),
+			},
+			// Test that a changed image is uploaded again.
+			{
+				Config: config,
+				PreConfig: func() {
+					if err := os.WriteFile(dir+"/example.png", changedImage, 0o600); err != nil {
+						t.Fatalf("unable to write image: %s", err)
+					}
+
+					gock.OffAll()
+					gock.New(testURL).
+						Get("/changelogs/" + mockChangelogs[0].Slug).
+						Times(1).
+						Reply(200).
+						JSON(uploaded("https://files.readme.io/1-example.png"))
+					mockUpload("https://files.readme.io/2-example.png")
+					gock.New(testURL).
+						Put("/changelogs/" + mockChangelogs[0].Slug).
+						MatchType("json").
+						BodyString(regexp.QuoteMeta("https://files.readme.io/2-example.png")).
+						Times(1).
+						Reply(200).
+						JSON(uploaded("https://files.readme.io/2-example.png"))
+					gock.New(testURL).
+						Get("/changelogs/" + mockChangelogs[0].Slug).
+						Persist().
+						Reply(200).
+						JSON(uploaded("https://files.readme.io/2-example.png"))
+					gock.New(testURL).
+						Delete("/changelogs/" + mockChangelogs[0].Slug).
+						Times(1).
+						Reply(204)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_changelog.test", "uploaded_images.%", "1"),
+					resource.TestCheckResourceAttr(
+						"readme_changelog.test",
+						"uploaded_images."+sha256Sum(changedImage),
+						"https://files.readme.io/2-example.png",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestChangelogResource_FrontMatterFormats(t *testing.T) {
+	testCases := []struct {
+		name   string
+		format string
+		body   string
+		line   int
+	}{
+		{
+			name: "yaml",
+			body: "---\ntitle: Turtle Update\ntype: improved\nhidden: true\n---\nA turtle has been here.",
+			line: 2,
+		},
+		{
+			name: "toml",
+			body: "+++\ntitle = \"Turtle Update\"\ntype = \"improved\"\nhidden = true\n+++\nA turtle has been here.",
+			line: 2,
+		},
+		{
+			name: "json with ;;; delimiters",
+			body: ";;;\n{\n\"title\": \"Turtle Update\",\n\"type\": \"improved\",\n\"hidden\": true\n}\n;;;\n" +
+				"A turtle has been here.",
+			line: 3,
+		},
+		{
+			name:   "format override",
+			format: `front_matter_format = "json"`,
+			body:   "---\n{\"title\": \"Turtle Update\", \"type\": \"improved\", \"hidden\": true}\n---\nA turtle has been here.",
+			line:   2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Close all gocks when completed.
+			defer gock.OffAll()
+
+			changelog := mockChangelogs[0]
+			changelog.Title = "Turtle Update"
+			changelog.Type = "improved"
+			changelog.Hidden = true
+			// The front matter isn't published with the body.
+			changelog.Body = "A turtle has been here."
+
+			config := func(attributes string) string {
+				return providerConfig + fmt.Sprintf(`
+					resource "readme_changelog" "test" {
+						%s
+						%s
+						body = <<-EOT
+%s
+EOT
+					}`,
+					testCase.format, attributes, testCase.body,
+				)
+			}
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					// Test that strict mode reports the line numbers of the keys.
+					{
+						Config: config(`
+							title             = "Attribute Title"
+							front_matter_mode = "strict"
+						`),
+						ExpectError: regexp.MustCompile(
+							fmt.Sprintf(`line %d: key "title" conflicts with the "title" attribute`, testCase.line),
+						),
+					},
+					// Test that the attributes are set from the front matter.
+					{
+						Config: config(""),
+						PreConfig: func() {
+							gock.New(testURL).
+								Post("/changelogs").
+								BodyString(regexp.QuoteMeta(`"hidden":true,"title":"Turtle Update","type":"improved"`)).
+								Times(1).
+								Reply(201).
+								JSON(changelog)
+							gock.New(testURL).Get("/changelogs/" + changelog.Slug).Persist().Reply(200).JSON(changelog)
+							gock.New(testURL).Delete("/changelogs/" + changelog.Slug).Times(1).Reply(204)
+						},
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("readme_changelog.test", "title", changelog.Title),
+							resource.TestCheckResourceAttr("readme_changelog.test", "type", changelog.Type),
+							resource.TestCheckResourceAttr("readme_changelog.test", "hidden", "true"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package readme
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &changelogsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &changelogsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &changelogsDataSource{}
+)
+
+const (
+	// changelogsPerPage is the number of changelogs that are requested on each page of the changelog list.
+	changelogsPerPage = 100
+
+	// changelogsSortAsc sorts changelogs from the oldest to the newest.
+	changelogsSortAsc = "asc"
+
+	// changelogsSortDesc sorts changelogs from the newest to the oldest.
+	changelogsSortDesc = "desc"
+)
+
+// changelogTypes are the valid types of changelogs.
+var changelogTypes = []string{"added", "fixed", "improved", "deprecated", "removed"}
+
+type changelogsDataSource struct {
+	client *readme.Client
+}
+
+type changelogsDataSourceModel struct {
+	CreatedAfter  types.String               `tfsdk:"created_after"`
+	CreatedBefore types.String               `tfsdk:"created_before"`
+	Hidden        types.Bool                 `tfsdk:"hidden"`
+	ID            types.String               `tfsdk:"id"`
+	Results       []changelogDataSourceModel `tfsdk:"results"`
+	Sort          types.String               `tfsdk:"sort"`
+	Type          types.String               `tfsdk:"type"`
+}
+
+// changelogEntry is a changelog with its parsed creation time.
+type changelogEntry struct {
+	changelog readme.Changelog
+	createdAt time.Time
+}
+
+// NewChangelogsDataSource is a helper function to simplify the provider implementation.
+func NewChangelogsDataSource() datasource.DataSource {
+	return &changelogsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *changelogsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_changelogs"
+}
+
+// ValidateConfig is used for validating attribute values.
+func (d *changelogsDataSource) ValidateConfig(
+	ctx context.Context,
+	req datasource.ValidateConfigRequest,
+	resp *datasource.ValidateConfigResponse,
+) {
+	var data changelogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Type.IsNull() && !data.Type.IsUnknown() && !slices.Contains(changelogTypes, data.Type.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid changelog type.",
+			fmt.Sprintf("type must be one of 'added', 'fixed', 'improved', 'deprecated', or 'removed', got '%s'.",
+				data.Type.ValueString()),
+		)
+	}
+
+	if !data.Sort.IsNull() && !data.Sort.IsUnknown() &&
+		data.Sort.ValueString() != changelogsSortAsc && data.Sort.ValueString() != changelogsSortDesc {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sort"),
+			"Invalid sort order.",
+			fmt.Sprintf("sort must be '%s' or '%s', got '%s'.",
+				changelogsSortAsc, changelogsSortDesc, data.Sort.ValueString()),
+		)
+	}
+
+	for name, value := range map[string]types.String{
+		"created_after":  data.CreatedAfter,
+		"created_before": data.CreatedBefore,
+	} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid timestamp.",
+				fmt.Sprintf("%s must be an RFC 3339 timestamp, got '%s'.", name, value.ValueString()),
+			)
+		}
+	}
+}
+
+// getAllChangelogs retrieves every page of the changelog list.
+//
+// The API client only retrieves the first page of changelogs, so the pages are requested until a page isn't full or
+// the pagination header doesn't link to a next page.
+func (d *changelogsDataSource) getAllChangelogs() ([]readme.Changelog, error) {
+	changelogs := []readme.Changelog{}
+
+	for page := 1; ; page++ {
+		var response []readme.Changelog
+
+		apiResponse, err := d.client.APIRequest(&readme.APIRequest{
+			Method:       "GET",
+			Endpoint:     fmt.Sprintf("%s?perPage=%d&page=%d", readme.ChangelogEndpoint, changelogsPerPage, page),
+			UseAuth:      true,
+			OkStatusCode: []int{200},
+			Response:     &response,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve page %d of changelogs: %s", page, clientError(err, apiResponse))
+		}
+
+		changelogs = append(changelogs, response...)
+
+		if len(response) < changelogsPerPage {
+			return changelogs, nil
+		}
+
+		hasNextPage, err := readme.HasNextPage(apiResponse.HTTPResponse.Header.Get(readme.PaginationHeader))
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve page %d of changelogs: %w", page, err)
+		}
+
+		if !hasNextPage {
+			return changelogs, nil
+		}
+	}
+}
+
+// changelogsDataSourceFilters are the parsed creation time filters of the configuration.
+type changelogsDataSourceFilters struct {
+	createdAfter  *time.Time
+	createdBefore *time.Time
+}
+
+// parseFilters parses the creation time filters of the configuration.
+//
+// ValidateConfig skips values that are unknown at plan time, so the values are checked again when they're known.
+func (m changelogsDataSourceModel) parseFilters() (changelogsDataSourceFilters, diag.Diagnostics) {
+	var filters changelogsDataSourceFilters
+
+	var diags diag.Diagnostics
+
+	for name, value := range map[string]types.String{
+		"created_after":  m.CreatedAfter,
+		"created_before": m.CreatedBefore,
+	} {
+		if value.IsNull() {
+			continue
+		}
+
+		timestamp, err := time.Parse(time.RFC3339, value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid timestamp.",
+				fmt.Sprintf("%s must be an RFC 3339 timestamp, got '%s'.", name, value.ValueString()),
+			)
+
+			continue
+		}
+
+		if name == "created_after" {
+			filters.createdAfter = &timestamp
+		} else {
+			filters.createdBefore = &timestamp
+		}
+	}
+
+	return filters, diags
+}
+
+// filterChangelogs returns the changelogs that match the filters, sorted by their creation time.
+func filterChangelogs(
+	changelogs []readme.Changelog,
+	state changelogsDataSourceModel,
+	filters changelogsDataSourceFilters,
+) ([]readme.Changelog, error) {
+	entries := []changelogEntry{}
+
+	for _, changelog := range changelogs {
+		createdAt, err := time.Parse(time.RFC3339, changelog.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the creation time of changelog %s: %w", changelog.Slug, err)
+		}
+
+		if !state.Type.IsNull() && changelog.Type != state.Type.ValueString() {
+			continue
+		}
+
+		if !state.Hidden.IsNull() && changelog.Hidden != state.Hidden.ValueBool() {
+			continue
+		}
+
+		if filters.createdAfter != nil && !createdAt.After(*filters.createdAfter) {
+			continue
+		}
+
+		if filters.createdBefore != nil && !createdAt.Before(*filters.createdBefore) {
+			continue
+		}
+
+		entries = append(entries, changelogEntry{changelog: changelog, createdAt: createdAt})
+	}
+
+	ascending := state.Sort.ValueString() == changelogsSortAsc
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if ascending {
+			return entries[i].createdAt.Before(entries[j].createdAt)
+		}
+
+		return entries[i].createdAt.After(entries[j].createdAt)
+	})
+
+	filtered := []readme.Changelog{}
+	for _, entry := range entries {
+		filtered = append(filtered, entry.changelog)
+	}
+
+	return filtered, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *changelogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state changelogsDataSourceModel
+
+	// Get config.
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, diags := state.parseFilters()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changelogs, err := d.getAllChangelogs()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve changelogs.", err.Error())
+
+		return
+	}
+
+	changelogs, err = filterChangelogs(changelogs, state, filters)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to filter changelogs.", err.Error())
+
+		return
+	}
+
+	results := []changelogDataSourceModel{}
+	for _, changelog := range changelogs {
+		results = append(results, changelogDatasourceMapToModel(changelog))
+	}
+
+	state.ID = types.StringValue("changelogs")
+	state.Results = results
+
+	// Set state.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *changelogsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*readme.Client)
+}
+
+// Schema for the readme_changelogs data source.
+func (d *changelogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve changelogs from the ReadMe API.\n\n" +
+			"Every page of the changelog list is retrieved and the filters are applied to the full list. The " +
+			"changelogs are sorted by their creation time.\n\n" +
+			"See <https://docs.readme.com/reference/getchangelogs> for more information about the API.",
+		Attributes: map[string]schema.Attribute{
+			"created_after": schema.StringAttribute{
+				Description: "Only include the changelogs that were created after this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"created_before": schema.StringAttribute{
+				Description: "Only include the changelogs that were created before this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"hidden": schema.BoolAttribute{
+				Description: "Only include hidden changelogs when true or visible changelogs when false.",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The state ID of the changelogs data source.",
+				Computed:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The changelogs that match the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: changelogDataSourceSchema(),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Description: fmt.Sprintf("The order to sort the changelogs by their creation time, either `%s` for "+
+					"the oldest first or `%s` for the newest first. Defaults to `%s`.",
+					changelogsSortAsc, changelogsSortDesc, changelogsSortDesc),
+				Optional: true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only include the changelogs of this type. Must be one of `added`, `fixed`, `improved`, " +
+					"`deprecated`, or `removed`.",
+				Optional: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,246 @@
+// nolint:goconst // Intentional repetition of some values for tests.
+package readme
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestChangelogsDataSource(t *testing.T) {
+	changelogs := []readme.Changelog{
+		{Slug: "turtles", Title: "Turtles", Type: "added", CreatedAt: "2023-02-01T00:00:00.000Z"},
+		{Slug: "lizards", Title: "Lizards", Type: "fixed", CreatedAt: "2023-03-01T00:00:00.000Z", Hidden: true},
+		{Slug: "frogs", Title: "Frogs", Type: "added", CreatedAt: "2023-01-01T00:00:00.000Z"},
+	}
+
+	testCases := []struct {
+		name   string
+		config string
+		slugs  []string
+	}{
+		{
+			name:   "all changelogs",
+			config: "",
+			slugs:  []string{"lizards", "turtles", "frogs"},
+		},
+		{
+			name:   "ascending",
+			config: `sort = "asc"`,
+			slugs:  []string{"frogs", "turtles", "lizards"},
+		},
+		{
+			name:   "type",
+			config: `type = "added"`,
+			slugs:  []string{"turtles", "frogs"},
+		},
+		{
+			name:   "hidden",
+			config: `hidden = false`,
+			slugs:  []string{"turtles", "frogs"},
+		},
+		{
+			name:   "created range",
+			config: "created_after = \"2023-01-01T00:00:00Z\"\ncreated_before = \"2023-03-01T00:00:00Z\"",
+			slugs:  []string{"turtles"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Close all gocks when completed.
+			defer gock.OffAll()
+
+			checks := []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr(
+					"data.readme_changelogs.test",
+					"results.#",
+					fmt.Sprintf("%d", len(testCase.slugs)),
+				),
+			}
+
+			for i, slug := range testCase.slugs {
+				checks = append(checks, resource.TestCheckResourceAttr(
+					"data.readme_changelogs.test",
+					fmt.Sprintf("results.%d.slug", i),
+					slug,
+				))
+			}
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						PreConfig: func() {
+							gock.OffAll()
+							gock.New(testURL).
+								Get("/changelogs").
+								MatchParam("perPage", "100").
+								MatchParam("page", "1").
+								Persist().
+								Reply(200).
+								JSON(changelogs)
+						},
+						Config: providerConfig + fmt.Sprintf(`
+							data "readme_changelogs" "test" {
+								%s
+							}
+						`, testCase.config),
+						Check: resource.ComposeAggregateTestCheckFunc(checks...),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestChangelogsDataSource_Pagination(t *testing.T) {
+	// Close all gocks when completed.
+	defer gock.OffAll()
+
+	firstPage := []readme.Changelog{}
+	for i := 0; i < 100; i++ {
+		firstPage = append(firstPage, readme.Changelog{
+			Slug:      fmt.Sprintf("changelog-%d", i),
+			Title:     fmt.Sprintf("Changelog %d", i),
+			Type:      "improved",
+			CreatedAt: fmt.Sprintf("2023-01-01T00:%02d:00.000Z", i%60),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Get("/changelogs").
+						MatchParam("perPage", "100").
+						MatchParam("page", "1").
+						Persist().
+						Reply(200).
+						AddHeader("link", `</changelogs?page=2>; rel="next", <>; rel="prev", <>; rel="last"`).
+						JSON(firstPage)
+					gock.New(testURL).
+						Get("/changelogs").
+						MatchParam("perPage", "100").
+						MatchParam("page", "2").
+						Persist().
+						Reply(200).
+						JSON([]readme.Changelog{{Slug: "latest", Type: "added", CreatedAt: "2023-06-01T00:00:00.000Z"}})
+				},
+				Config: providerConfig + `data "readme_changelogs" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readme_changelogs.test", "results.#", "101"),
+					resource.TestCheckResourceAttr("data.readme_changelogs.test", "results.0.slug", "latest"),
+				),
+			},
+		},
+	})
+}
+
+func TestChangelogsDataSource_Errors(t *testing.T) {
+	testCases := []struct {
+		config string
+		mocks  func()
+		error  string
+	}{
+		{config: `type = "changed"`, error: "type must be one of 'added', 'fixed', 'improved', 'deprecated', or"},
+		{config: `sort = "newest"`, error: "sort must be 'asc' or 'desc', got 'newest'"},
+		{config: `created_before = "tomorrow"`, error: "created_before must be an RFC 3339 timestamp"},
+		{
+			config: "",
+			mocks: func() {
+				gock.New(testURL).
+					Get("/changelogs").
+					Persist().
+					Reply(401).
+					JSON(map[string]string{})
+			},
+			error: "unable to retrieve page 1 of changelogs",
+		},
+		{
+			config: "",
+			mocks: func() {
+				gock.New(testURL).
+					Get("/changelogs").
+					Persist().
+					Reply(200).
+					JSON([]readme.Changelog{{Slug: "turtles", CreatedAt: "yesterday"}})
+			},
+			error: "unable to parse the creation time of changelog turtles",
+		},
+	}
+
+	for _, testCase := range testCases {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					PreConfig: func() {
+						gock.OffAll()
+
+						if testCase.mocks != nil {
+							testCase.mocks()
+						}
+					},
+					Config: providerConfig + fmt.Sprintf(`
+						data "readme_changelogs" "test" {
+							%s
+						}
+					`, testCase.config),
+					ExpectError: regexp.MustCompile(testCase.error),
+				},
+			},
+		})
+
+		gock.OffAll()
+	}
+}
+
+func TestChangelogsDataSourceModel_ParseFilters(t *testing.T) {
+	// The values are only checked by ValidateConfig when they're known at plan time, so the filters must report
+	// values that are invalid when they're read.
+	testCases := []struct {
+		model     changelogsDataSourceModel
+		attribute string
+	}{
+		{model: changelogsDataSourceModel{CreatedAfter: types.StringValue("yesterday")}, attribute: "created_after"},
+		{model: changelogsDataSourceModel{CreatedBefore: types.StringValue("tomorrow")}, attribute: "created_before"},
+	}
+
+	for _, testCase := range testCases {
+		_, diags := testCase.model.parseFilters()
+		if !diags.HasError() {
+			t.Fatalf("Expected an error for %s", testCase.attribute)
+		}
+
+		attributeDiag, ok := diags[0].(diag.DiagnosticWithPath)
+		if !ok || !attributeDiag.Path().Equal(path.Root(testCase.attribute)) {
+			t.Fatalf("Expected an attribute error for %s, got: %v", testCase.attribute, diags)
+		}
+	}
+
+	filters, diags := changelogsDataSourceModel{
+		CreatedAfter:  types.StringNull(),
+		CreatedBefore: types.StringValue("2023-01-01T00:00:00Z"),
+	}.parseFilters()
+	if diags.HasError() {
+		t.Fatalf("Expected no errors, got: %v", diags)
+	}
+
+	if filters.createdAfter != nil || filters.createdBefore == nil {
+		t.Fatalf("Expected the created_before filter only, got: %+v", filters)
+	}
+}
@@ -73,22 +73,57 @@ func customPageResourceMapToModel(
 		plan.HTML = types.StringValue("")
 	}
 
+	if plan.StoreBody.IsNull() || plan.StoreBody.IsUnknown() {
+		plan.StoreBody = types.BoolValue(true)
+	}
+
+	if plan.UploadImages.IsNull() || plan.UploadImages.IsUnknown() {
+		plan.UploadImages = types.BoolValue(false)
+	}
+
+	if plan.UploadedImages.IsNull() || plan.UploadedImages.IsUnknown() {
+		plan.UploadedImages = types.MapNull(types.StringType)
+	}
+
+	if plan.TemplateVars.IsNull() || plan.TemplateVars.IsUnknown() {
+		plan.TemplateVars = types.MapNull(types.StringType)
+	}
+
+	bodyClean := types.StringValue(page.Body)
+	if storeBodyDisabled(plan.SourceFile, plan.StoreBody) {
+		bodyClean = types.StringValue(bodyHash(page.Body))
+	}
+
 	return customPageResourceModel{
-		Algolia:    docModelAlgoliaValue(page.Algolia),
-		Body:       plan.Body,
-		BodyClean:  types.StringValue(page.Body),
-		CreatedAt:  types.StringValue(page.CreatedAt),
-		FullScreen: types.BoolValue(page.Fullscreen),
-		HTML:       plan.HTML,
-		HTMLClean:  types.StringValue(page.HTML),
-		HTMLMode:   types.BoolValue(page.HTMLMode),
-		Hidden:     types.BoolValue(page.Hidden),
-		ID:         types.StringValue(page.ID),
-		Metadata:   docModelMetadataValue(page.Metadata),
-		Revision:   types.Int64Value(int64(page.Revision)),
-		Slug:       types.StringValue(page.Slug),
-		Title:      types.StringValue(page.Title),
-		UpdatedAt:  types.StringValue(page.UpdatedAt),
+		Algolia:                docModelAlgoliaValue(page.Algolia),
+		Body:                   plan.Body,
+		BodyClean:              bodyClean,
+		BodyFormat:             bodyFormatValue(plan.BodyFormat),
+		CreatedAt:              types.StringValue(page.CreatedAt),
+		FrontMatterFormat:      plan.FrontMatterFormat,
+		FrontMatterMode:        plan.FrontMatterMode,
+		FrontMatterPassthrough: plan.FrontMatterPassthrough,
+		FullScreen:             types.BoolValue(page.Fullscreen),
+		HTML:                   plan.HTML,
+		HTMLClean:              types.StringValue(page.HTML),
+		HTMLMode:               types.BoolValue(page.HTMLMode),
+		Hidden:                 types.BoolValue(page.Hidden),
+		ID:                     types.StringValue(page.ID),
+		ImageBaseDir:           plan.ImageBaseDir,
+		Metadata:               docModelMetadataValue(page.Metadata),
+		Revision:               types.Int64Value(int64(page.Revision)),
+		SearchIndexTimeout:     plan.SearchIndexTimeout,
+		Slug:                   types.StringValue(page.Slug),
+		SourceFile:             plan.SourceFile,
+		SourceSHA256:           plan.SourceSHA256,
+		StoreBody:              plan.StoreBody,
+		TemplateVars:           plan.TemplateVars,
+		Timeouts:               plan.Timeouts,
+		Title:                  types.StringValue(page.Title),
+		UpdatedAt:              types.StringValue(page.UpdatedAt),
+		UploadImages:           plan.UploadImages,
+		UploadedImages:         plan.UploadedImages,
+		WaitForSearchIndex:     plan.WaitForSearchIndex,
 	}
 }
 
@@ -2,8 +2,14 @@ package readme
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,30 +26,58 @@ var (
 	_ resource.Resource                = &customPageResource{}
 	_ resource.ResourceWithConfigure   = &customPageResource{}
 	_ resource.ResourceWithImportState = &customPageResource{}
+	_ resource.ResourceWithModifyPlan  = &customPageResource{}
 )
 
 // customPageResource is the data source implementation.
+// customPageFrontMatterKeys maps the front matter keys supported by the readme_custom_page resource to the
+// attributes they set.
+var customPageFrontMatterKeys = map[string]string{
+	"body":       "body",
+	"fullscreen": "fullscreen",
+	"hidden":     "hidden",
+	"html":       "html",
+	"htmlmode":   "html_mode",
+	"metadata":   "metadata",
+	"title":      "title",
+}
+
 type customPageResource struct {
-	client *readme.Client
+	client          *readme.Client
+	frontMatterMode string
 }
 
 // customPageResourceModel is the resource model used by the readme_custom_page resource.
 type customPageResourceModel struct {
-	Algolia    types.Object `tfsdk:"algolia"`
-	Body       types.String `tfsdk:"body"`
-	BodyClean  types.String `tfsdk:"body_clean"`
-	CreatedAt  types.String `tfsdk:"created_at"`
-	FullScreen types.Bool   `tfsdk:"fullscreen"`
-	HTML       types.String `tfsdk:"html"`
-	HTMLClean  types.String `tfsdk:"html_clean"`
-	HTMLMode   types.Bool   `tfsdk:"html_mode"`
-	Hidden     types.Bool   `tfsdk:"hidden"`
-	ID         types.String `tfsdk:"id"`
-	Metadata   types.Object `tfsdk:"metadata"`
-	Revision   types.Int64  `tfsdk:"revision"`
-	Slug       types.String `tfsdk:"slug"`
-	Title      types.String `tfsdk:"title"`
-	UpdatedAt  types.String `tfsdk:"updated_at"`
+	Algolia                types.Object   `tfsdk:"algolia"`
+	Body                   types.String   `tfsdk:"body"`
+	BodyClean              types.String   `tfsdk:"body_clean"`
+	BodyFormat             types.String   `tfsdk:"body_format"`
+	CreatedAt              types.String   `tfsdk:"created_at"`
+	FrontMatterFormat      types.String   `tfsdk:"front_matter_format"`
+	FrontMatterMode        types.String   `tfsdk:"front_matter_mode"`
+	FrontMatterPassthrough types.Bool     `tfsdk:"front_matter_passthrough"`
+	FullScreen             types.Bool     `tfsdk:"fullscreen"`
+	HTML                   types.String   `tfsdk:"html"`
+	HTMLClean              types.String   `tfsdk:"html_clean"`
+	HTMLMode               types.Bool     `tfsdk:"html_mode"`
+	Hidden                 types.Bool     `tfsdk:"hidden"`
+	ID                     types.String   `tfsdk:"id"`
+	ImageBaseDir           types.String   `tfsdk:"image_base_dir"`
+	Metadata               types.Object   `tfsdk:"metadata"`
+	Revision               types.Int64    `tfsdk:"revision"`
+	SearchIndexTimeout     types.String   `tfsdk:"search_index_timeout"`
+	Slug                   types.String   `tfsdk:"slug"`
+	SourceFile             types.String   `tfsdk:"source_file"`
+	SourceSHA256           types.String   `tfsdk:"source_sha256"`
+	StoreBody              types.Bool     `tfsdk:"store_body"`
+	TemplateVars           types.Map      `tfsdk:"template_vars"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+	Title                  types.String   `tfsdk:"title"`
+	UpdatedAt              types.String   `tfsdk:"updated_at"`
+	UploadImages           types.Bool     `tfsdk:"upload_images"`
+	UploadedImages         types.Map      `tfsdk:"uploaded_images"`
+	WaitForSearchIndex     types.Bool     `tfsdk:"wait_for_search_index"`
 }
 
 // NewCustomPageResource is a helper function to simplify the provider implementation.
@@ -70,7 +104,14 @@ func (r *customPageResource) Configure(
 		return
 	}
 
-	r.client = req.ProviderData.(*readme.Client)
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.frontMatterMode = data.frontMatterMode
+}
+
+// frontMatterSettings returns the front matter settings for a custom page plan.
+func (r *customPageResource) frontMatterSettings(plan customPageResourceModel) frontMatterSettings {
+	return newFrontMatterSettings(plan.FrontMatterFormat, plan.FrontMatterMode, plan.FrontMatterPassthrough, r.frontMatterMode)
 }
 
 // ValidateConfig is used for validating attribute values.
@@ -81,10 +122,26 @@ func (r customPageResource) ValidateConfig(
 ) {
 	var data customPageResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	resp.Diagnostics.Append(validateBodyFormat(data.BodyFormat)...)
+	resp.Diagnostics.Append(validateFrontMatterFormat(data.FrontMatterFormat)...)
+	resp.Diagnostics.Append(validateFrontMatterMode(data.FrontMatterMode)...)
+	resp.Diagnostics.Append(validateSearchIndexTimeout(data.SearchIndexTimeout)...)
+
+	body, diags := configBody(data.Body, data.SourceFile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Front matter can't be checked until the template variables are known.
+	vars, known := templateVars(ctx, data.TemplateVars)
+	if !known {
+		return
+	}
 
 	if data.Title.IsNull() {
 		// check front matter for 'title'.
-		titleMatter, diag := frontmatter.GetValue(ctx, data.Body.ValueString(), "Title")
+		titleMatter, diag := frontmatter.GetValue(ctx, body, "Title", vars, data.FrontMatterFormat.ValueString())
 		if diag != "" {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("title"),
@@ -108,6 +165,115 @@ func (r customPageResource) ValidateConfig(
 	}
 }
 
+// ModifyPlan reads the body from the source file if one is set.
+func (r *customPageResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	plan := &customPageResourceModel{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	state := &customPageResourceModel{}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+
+	var stateBody, stateSHA256 types.String
+	if state != nil {
+		stateBody, stateSHA256 = state.Body, state.SourceSHA256
+	}
+
+	source, diags := planSourceFile(plan.Body, plan.SourceFile, plan.StoreBody, stateBody, stateSHA256)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Body = source.Body
+	plan.SourceSHA256 = source.SHA256
+
+	// Render the body as a template.
+	content, rendered, diags := planTemplate(ctx, source.Content, plan.TemplateVars, plan.SourceFile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate the front matter keys.
+	if rendered {
+		var config customPageResourceModel
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		resp.Diagnostics.Append(checkFrontMatter(
+			content,
+			r.frontMatterSettings(*plan),
+			plan.SourceFile,
+			customPageFrontMatterKeys,
+			configuredAttributes(map[string]attr.Value{
+				"hidden":    config.Hidden,
+				"html":      config.HTML,
+				"html_mode": config.HTMLMode,
+				"title":     config.Title,
+			}),
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Expand the include directives in the body.
+	content, diags = planIncludes(content, plan.SourceFile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The body defaults to an empty string when it's not set and not read from a file.
+	var configBody types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("body"), &configBody)...)
+	if configBody.IsNull() && plan.SourceFile.IsNull() {
+		plan.Body = types.StringValue("")
+	}
+
+	// Rewrite references to local images that have already been uploaded.
+	var stateUploaded types.Map
+	if state != nil {
+		stateUploaded = state.UploadedImages
+	}
+
+	images, diags := planImages(
+		ctx,
+		formatBody(content, plan.BodyFormat),
+		plan.UploadImages,
+		imageBaseDir(plan.ImageBaseDir, plan.SourceFile),
+		stateUploaded,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.UploadedImages = images.Uploaded
+
+	// A change to the source file, its images, an included file, or the template variables doesn't change the
+	// body configuration, so the attributes that are refreshed after an update are set to unknown.
+	if state != nil && (!plan.SourceSHA256.Equal(state.SourceSHA256) || !images.Known || !rendered ||
+		!plan.TemplateVars.Equal(state.TemplateVars) || includesChanged(source.Content, images.Body, plan, state)) {
+		plan.Algolia = types.ObjectUnknown(map[string]attr.Type{
+			"publish_pending": types.BoolType,
+			"record_count":    types.Int64Type,
+			"updated_at":      types.StringType,
+		})
+		plan.BodyClean = types.StringUnknown()
+		plan.Revision = types.Int64Unknown()
+		plan.UpdatedAt = types.StringUnknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
 // Create creates the custom page and sets the initial Terraform state.
 func (r *customPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan customPageResourceModel
@@ -117,27 +283,87 @@ func (r *customPageResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Create, operationCreate, &resp.Diagnostics)
+	defer done()
+
+	body, err := sourceFileBody(plan.Body, plan.SourceFile, plan.SourceSHA256)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create custom page.", err.Error())
+
+		return
+	}
+
+	body, err = renderBody(ctx, body, plan.TemplateVars)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create custom page.", err.Error())
+
+		return
+	}
+
+	body, err = expandIncludes(body, includeBaseDir(plan.SourceFile))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create custom page.", err.Error())
+
+		return
+	}
+
+	body = formatBody(body, plan.BodyFormat)
+
+	if plan.UploadImages.ValueBool() {
+		baseDir := imageBaseDir(plan.ImageBaseDir, plan.SourceFile)
+		body, plan.UploadedImages, err = uploadImages(ctx, r.client, body, baseDir, types.MapNull(types.StringType))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to create custom page.", err.Error())
+
+			return
+		}
+	}
+
 	params := readme.CustomPageParams{
 		Title:    plan.Title.ValueString(),
-		Body:     plan.Body.ValueString(),
+		Body:     body,
 		HTML:     plan.HTML.ValueString(),
 		HTMLMode: plan.HTMLMode.ValueBoolPointer(),
 		Hidden:   plan.Hidden.ValueBoolPointer(),
 	}
 
-	page, _, err := r.client.CustomPage.Create(params)
+	page, _, err := saveCustomPage(r.client, "", params, r.frontMatterSettings(plan))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create custom page.", err.Error())
 
 		return
 	}
 
-	state := customPageResourceMapToModel(page, plan)
+	state, diags := r.waitForSearchIndex(ctx, customPageResourceMapToModel(page, plan))
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
+// waitForSearchIndex waits for a saved custom page to be indexed for search when `wait_for_search_index` is true.
+func (r *customPageResource) waitForSearchIndex(
+	ctx context.Context,
+	state customPageResourceModel,
+) (customPageResourceModel, diag.Diagnostics) {
+	return waitForSearchIndex(
+		ctx,
+		state.WaitForSearchIndex,
+		state.SearchIndexTimeout,
+		fmt.Sprintf("custom page '%s'", state.Slug.ValueString()),
+		state,
+		func(model customPageResourceModel) types.Object { return model.Algolia },
+		func() (customPageResourceModel, error) {
+			page, apiResponse, err := r.client.CustomPage.Get(state.Slug.ValueString())
+			if err != nil {
+				return state, errors.New(clientError(err, apiResponse))
+			}
+
+			return customPageResourceMapToModel(page, state), nil
+		},
+	)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *customPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var plan, state customPageResourceModel
@@ -147,6 +373,9 @@ func (r *customPageResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Read, operationRead, &resp.Diagnostics)
+	defer done()
+
 	page, _, err := r.client.CustomPage.Get(state.Slug.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to retrieve custom page.", err.Error())
@@ -169,24 +398,61 @@ func (r *customPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Update, operationUpdate, &resp.Diagnostics)
+	defer done()
+
+	body, err := sourceFileBody(plan.Body, plan.SourceFile, plan.SourceSHA256)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update custom page.", err.Error())
+
+		return
+	}
+
+	body, err = renderBody(ctx, body, plan.TemplateVars)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update custom page.", err.Error())
+
+		return
+	}
+
+	body, err = expandIncludes(body, includeBaseDir(plan.SourceFile))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update custom page.", err.Error())
+
+		return
+	}
+
+	body = formatBody(body, plan.BodyFormat)
+
+	if plan.UploadImages.ValueBool() {
+		baseDir := imageBaseDir(plan.ImageBaseDir, plan.SourceFile)
+		body, plan.UploadedImages, err = uploadImages(ctx, r.client, body, baseDir, state.UploadedImages)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to update custom page.", err.Error())
+
+			return
+		}
+	}
+
 	params := readme.CustomPageParams{
 		Title:    plan.Title.ValueString(),
-		Body:     plan.Body.ValueString(),
+		Body:     body,
 		HTML:     plan.HTML.ValueString(),
 		HTMLMode: plan.HTMLMode.ValueBoolPointer(),
 		Hidden:   plan.Hidden.ValueBoolPointer(),
 	}
 
-	page, _, err := r.client.CustomPage.Update(state.Slug.ValueString(), params)
+	page, _, err := saveCustomPage(r.client, state.Slug.ValueString(), params, r.frontMatterSettings(plan))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update custom page.", err.Error())
 
 		return
 	}
 
-	state = customPageResourceMapToModel(page, plan)
+	state, diags := r.waitForSearchIndex(ctx, customPageResourceMapToModel(page, plan))
+	resp.Diagnostics.Append(diags...)
 
-	diags := resp.State.Set(ctx, state)
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -198,6 +464,9 @@ func (r *customPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	_, done := withTimeout(ctx, &r.client, state.Timeouts.Delete, operationDelete, &resp.Diagnostics)
+	defer done()
+
 	_, apiResponse, err := r.client.CustomPage.Delete(state.Slug.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to delete custom page", clientError(err, apiResponse))
@@ -215,11 +484,12 @@ func (r *customPageResource) ImportState(
 }
 
 // Schema for the readme_custom_page resource.
-func (r *customPageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *customPageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage custom pages on ReadMe.com\n\n" +
 			"Custom pages on ReadMe support setting some attributes using front matter. " +
-			"Resource attributes take precedence over front matter attributes in the provider.\n\n" +
+			"Resource attributes take precedence over front matter attributes in the provider. The front matter is " +
+			"removed from the body that is published.\n\n" +
 			"Refer to <https://docs.readme.com/main/docs/rdme> for more information about using front matter in " +
 			"ReadMe docs and custom pages.\n\n" +
 			"See <https://docs.readme.com/main/reference/createcustompage> for more information about this API endpoint.",
@@ -238,10 +508,10 @@ func (r *customPageResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"body": schema.StringAttribute{
 				Description: "The body of the custom page. Optionally use front matter to set certain attributes. " +
-					"Alternatively, use the `html_mode` and `html` attributes to set the body in HTML format.",
+					"Alternatively, use the `html_mode` and `html` attributes to set the body in HTML format, or use " +
+					"`source_file` to read the body from a file." + includeDescription,
 				Computed: true,
 				Optional: true,
-				Default:  stringdefault.StaticString(""),
 			},
 			"body_clean": schema.StringAttribute{
 				Description: "The body of the custom page after normalization.",
@@ -265,8 +535,12 @@ func (r *customPageResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 			},
 			"fullscreen": schema.BoolAttribute{
-				Description: "Whether the custom page is in fullscreen mode.",
-				Computed:    true,
+				Description: "Whether the custom page is in fullscreen mode. This can be set using the `fullscreen` " +
+					"front matter key.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					frontmatter.GetBool("Fullscreen"),
+				},
 			},
 			"hidden": schema.BoolAttribute{
 				Description: "Whether the custom page is hidden. This can alternatively be set using the `hidden` front matter key.",
@@ -310,7 +584,12 @@ func (r *customPageResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"metadata": schema.SingleNestedAttribute{
+				Description: "Metadata about the custom page. This can be set using the `metadata` front matter key " +
+					"with nested `title`, `description`, and `image` keys.",
 				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					frontmatter.GetObject("Metadata"),
+				},
 				Attributes: map[string]schema.Attribute{
 					"description": schema.StringAttribute{
 						Computed: true,
@@ -318,6 +597,9 @@ func (r *customPageResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					"image": schema.ListAttribute{
 						Computed:    true,
 						ElementType: types.StringType,
+						PlanModifiers: []planmodifier.List{
+							frontmatter.GetList("Metadata.Image"),
+						},
 					},
 					"title": schema.StringAttribute{
 						Computed: true,
@@ -325,5 +607,51 @@ func (r *customPageResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+
+	for name, attribute := range sourceFileSchema() {
+		resp.Schema.Attributes[name] = attribute
 	}
+
+	for name, attribute := range uploadImagesSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range bodyFormatSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range templateVarsSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range searchIndexSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range frontMatterSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range frontMatterPassthroughSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+// includesChanged returns true if the body includes other files and the expanded body differs from the body in
+// the prior state.
+func includesChanged(content, expanded string, plan, state *customPageResourceModel) bool {
+	if !includeRegexp.MatchString(content) {
+		return false
+	}
+
+	body := strings.TrimSpace(expanded)
+	if storeBodyDisabled(plan.SourceFile, plan.StoreBody) {
+		body = bodyHash(body)
+	}
+
+	return state.BodyClean.ValueString() != body
 }
@@ -3,10 +3,12 @@ package readme
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
 	"gopkg.in/h2non/gock.v1"
 )
 
@@ -274,3 +276,249 @@ func TestCustomPageResource_HTML(t *testing.T) {
 		},
 	})
 }
+
+func TestCustomPageResource_SourceFile(t *testing.T) {
+	// Close all gocks when completed.
+	defer gock.OffAll()
+
+	sourceFile := t.TempDir() + "/page.md"
+	writeSourceFile := func(body string) {
+		if err := os.WriteFile(sourceFile, []byte(body), 0o600); err != nil {
+			t.Fatalf("unable to write source file: %s", err)
+		}
+	}
+
+	mockUpdatedCustomPage := mockCustomPages[0]
+	mockUpdatedCustomPage.Body = "This is an updated test custom page."
+
+	config := providerConfig + `
+		resource "readme_custom_page" "test" {
+			title       = "` + mockCustomPages[0].Title + `"
+			source_file = "` + sourceFile + `"
+		}`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that a missing source file results in an error.
+			{
+				Config: providerConfig + `
+					resource "readme_custom_page" "test" {
+						title       = "` + mockCustomPages[0].Title + `"
+						source_file = "` + sourceFile + `.missing"
+					}`,
+				ExpectError: regexp.MustCompile("Unable to read source file."),
+			},
+			// Test creating with the body read from the source file.
+			{
+				Config: config,
+				PreConfig: func() {
+					writeSourceFile(mockCustomPages[0].Body)
+					gock.OffAll()
+					gock.New(testURL).
+						Post("/custompages").
+						Times(1).
+						Reply(201).
+						JSON(mockCustomPages[0])
+					gock.New(testURL).
+						Get("/custompages/" + mockCustomPages[0].Slug).
+						Persist().
+						Reply(200).
+						JSON(mockCustomPages[0])
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_custom_page.test", "body", mockCustomPages[0].Body),
+					resource.TestCheckResourceAttr("readme_custom_page.test", "store_body", "true"),
+					resource.TestCheckResourceAttr(
+						"readme_custom_page.test",
+						"source_sha256",
+						sha256Hex(mockCustomPages[0].Body),
+					),
+				),
+			},
+			// Test that a change to the source file updates the custom page.
+			{
+				Config: config,
+				PreConfig: func() {
+					writeSourceFile(mockUpdatedCustomPage.Body)
+					gock.OffAll()
+					gock.New(testURL).
+						Get("/custompages/" + mockCustomPages[0].Slug).
+						Times(1).
+						Reply(200).
+						JSON(mockCustomPages[0])
+					gock.New(testURL).
+						Put("/custompages/" + mockCustomPages[0].Slug).
+						Times(1).
+						Reply(200).
+						JSON(mockUpdatedCustomPage)
+					gock.New(testURL).
+						Get("/custompages/" + mockCustomPages[0].Slug).
+						Persist().
+						Reply(200).
+						JSON(mockUpdatedCustomPage)
+					gock.New(testURL).
+						Delete("/custompages/" + mockCustomPages[0].Slug).
+						Times(1).
+						Reply(204)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"readme_custom_page.test",
+						"body",
+						mockUpdatedCustomPage.Body,
+					),
+					resource.TestCheckResourceAttr(
+						"readme_custom_page.test",
+						"source_sha256",
+						sha256Hex(mockUpdatedCustomPage.Body),
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestCustomPageResource_FrontMatterKeys(t *testing.T) {
+	// Close all gocks when completed.
+	defer gock.OffAll()
+
+	page := mockCustomPages[0]
+	page.Fullscreen = true
+	page.Metadata = readme.DocMetadata{Title: "Turtles", Description: "All about turtles.", Image: []any{}}
+	body := "---\n" +
+		"fullscreen: true\n" +
+		"metadata:\n  title: Turtles\n  description: All about turtles.\n" +
+		"---\n" +
+		"A turtle has been here."
+
+	// The front matter isn't published with the body.
+	page.Body = "A turtle has been here."
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that front matter keys that aren't supported by the API client are sent with the custom page.
+			{
+				Config: providerConfig + `
+					resource "readme_custom_page" "test" {
+						title = "` + page.Title + `"
+						body  = <<-EOT
+` + body + `
+EOT
+					}`,
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Post("/custompages").
+						BodyString(regexp.QuoteMeta(`"fullscreen":true,` +
+							`"metadata":{"description":"All about turtles.","image":[],"title":"Turtles"}`)).
+						Times(1).
+						Reply(201).
+						JSON(page)
+					gock.New(testURL).Get("/custompages/" + page.Slug).Persist().Reply(200).JSON(page)
+					gock.New(testURL).Delete("/custompages/" + page.Slug).Times(1).Reply(204)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_custom_page.test", "fullscreen", "true"),
+					resource.TestCheckResourceAttr("readme_custom_page.test", "metadata.title", "Turtles"),
+				),
+			},
+		},
+	})
+}
+
+func TestCustomPageResource_FrontMatterFormats(t *testing.T) {
+	testCases := []struct {
+		name   string
+		format string
+		body   string
+		line   int
+	}{
+		{
+			name: "yaml",
+			body: "---yaml\nhidden: true\ntitle: Turtle Page\n---\nA turtle has been here.",
+			line: 3,
+		},
+		{
+			name: "toml",
+			body: "---toml\nhidden = true\ntitle = \"Turtle Page\"\n---\nA turtle has been here.",
+			line: 3,
+		},
+		{
+			name: "json with ---json delimiter",
+			body: "---json\n{\n\"hidden\": true,\n\"title\": \"Turtle Page\"\n}\n---\nA turtle has been here.",
+			line: 4,
+		},
+		{
+			name:   "format override",
+			format: `front_matter_format = "yaml"`,
+			body:   "+++\nhidden: true\ntitle: Turtle Page\n+++\nA turtle has been here.",
+			line:   3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Close all gocks when completed.
+			defer gock.OffAll()
+
+			page := mockCustomPages[0]
+			page.Title = "Turtle Page"
+			page.Hidden = true
+			// The front matter isn't published with the body.
+			page.Body = "A turtle has been here."
+
+			config := func(attributes string) string {
+				return providerConfig + fmt.Sprintf(`
+					resource "readme_custom_page" "test" {
+						%s
+						%s
+						body = <<-EOT
+%s
+EOT
+					}`,
+					testCase.format, attributes, testCase.body,
+				)
+			}
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					// Test that strict mode reports the line numbers of the keys.
+					{
+						Config: config(`
+							title             = "Attribute Title"
+							front_matter_mode = "strict"
+						`),
+						ExpectError: regexp.MustCompile(
+							fmt.Sprintf(`line %d: key "title" conflicts with the "title" attribute`, testCase.line),
+						),
+					},
+					// Test that the attributes are set from the front matter.
+					{
+						Config: config(""),
+						PreConfig: func() {
+							gock.OffAll()
+							gock.New(testURL).
+								Post("/custompages").
+								BodyString(regexp.QuoteMeta(`"hidden":true,"htmlmode":false,"title":"Turtle Page"`)).
+								Times(1).
+								Reply(201).
+								JSON(page)
+							gock.New(testURL).Get("/custompages/" + page.Slug).Persist().Reply(200).JSON(page)
+							gock.New(testURL).Delete("/custompages/" + page.Slug).Times(1).Reply(204)
+						},
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("readme_custom_page.test", "title", page.Title),
+							resource.TestCheckResourceAttr("readme_custom_page.test", "hidden", "true"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -14,43 +15,58 @@ import (
 
 // docModel defines the fields and their types that map to the schemas.
 type docModel struct {
-	Algolia         types.Object `tfsdk:"algolia"`
-	API             types.Object `tfsdk:"api"`
-	Body            types.String `tfsdk:"body"`
-	BodyClean       types.String `tfsdk:"body_clean"`
-	BodyHTML        types.String `tfsdk:"body_html"`
-	Category        types.String `tfsdk:"category"`
-	CategorySlug    types.String `tfsdk:"category_slug"`
-	CreatedAt       types.String `tfsdk:"created_at"`
-	Deprecated      types.Bool   `tfsdk:"deprecated"`
-	Excerpt         types.String `tfsdk:"excerpt"`
-	Hidden          types.Bool   `tfsdk:"hidden"`
-	ID              types.String `tfsdk:"id"`
-	Icon            types.String `tfsdk:"icon"`
-	IsAPI           types.Bool   `tfsdk:"is_api"`
-	IsReference     types.Bool   `tfsdk:"is_reference"`
-	LinkExternal    types.Bool   `tfsdk:"link_external"`
-	LinkURL         types.String `tfsdk:"link_url"`
-	Error           types.Object `tfsdk:"error"`
-	Metadata        types.Object `tfsdk:"metadata"`
-	Next            types.Object `tfsdk:"next"`
-	ParentDoc       types.String `tfsdk:"parent_doc"`
-	ParentDocSlug   types.String `tfsdk:"parent_doc_slug"`
-	Order           types.Int64  `tfsdk:"order"`
-	PreviousSlug    types.String `tfsdk:"previous_slug"`
-	Project         types.String `tfsdk:"project"`
-	Revision        types.Int64  `tfsdk:"revision"`
-	Slug            types.String `tfsdk:"slug"`
-	SlugUpdatedAt   types.String `tfsdk:"slug_updated_at"`
-	SyncUnique      types.String `tfsdk:"sync_unique"`
-	Title           types.String `tfsdk:"title"`
-	Type            types.String `tfsdk:"type"`
-	UpdatedAt       types.String `tfsdk:"updated_at"`
-	User            types.String `tfsdk:"user"`
-	UseSlug         types.String `tfsdk:"use_slug"`
-	VerifyParentDoc types.Bool   `tfsdk:"verify_parent_doc"`
-	Version         types.String `tfsdk:"version"`
-	VersionID       types.String `tfsdk:"version_id"`
+	Algolia                types.Object   `tfsdk:"algolia"`
+	API                    types.Object   `tfsdk:"api"`
+	Body                   types.String   `tfsdk:"body"`
+	BodyClean              types.String   `tfsdk:"body_clean"`
+	BodyFormat             types.String   `tfsdk:"body_format"`
+	BodyHTML               types.String   `tfsdk:"body_html"`
+	Category               types.String   `tfsdk:"category"`
+	CategorySlug           types.String   `tfsdk:"category_slug"`
+	CreatedAt              types.String   `tfsdk:"created_at"`
+	Deprecated             types.Bool     `tfsdk:"deprecated"`
+	Excerpt                types.String   `tfsdk:"excerpt"`
+	FrontMatterFormat      types.String   `tfsdk:"front_matter_format"`
+	FrontMatterMode        types.String   `tfsdk:"front_matter_mode"`
+	FrontMatterPassthrough types.Bool     `tfsdk:"front_matter_passthrough"`
+	Hidden                 types.Bool     `tfsdk:"hidden"`
+	ID                     types.String   `tfsdk:"id"`
+	Icon                   types.String   `tfsdk:"icon"`
+	ImageBaseDir           types.String   `tfsdk:"image_base_dir"`
+	IsAPI                  types.Bool     `tfsdk:"is_api"`
+	IsReference            types.Bool     `tfsdk:"is_reference"`
+	LinkExternal           types.Bool     `tfsdk:"link_external"`
+	LinkCheck              types.String   `tfsdk:"link_check"`
+	LinkURL                types.String   `tfsdk:"link_url"`
+	Error                  types.Object   `tfsdk:"error"`
+	Metadata               types.Object   `tfsdk:"metadata"`
+	Next                   types.Object   `tfsdk:"next"`
+	ParentDoc              types.String   `tfsdk:"parent_doc"`
+	ParentDocSlug          types.String   `tfsdk:"parent_doc_slug"`
+	Order                  types.Int64    `tfsdk:"order"`
+	PreviousSlug           types.String   `tfsdk:"previous_slug"`
+	Project                types.String   `tfsdk:"project"`
+	Revision               types.Int64    `tfsdk:"revision"`
+	SearchIndexTimeout     types.String   `tfsdk:"search_index_timeout"`
+	Slug                   types.String   `tfsdk:"slug"`
+	SlugUpdatedAt          types.String   `tfsdk:"slug_updated_at"`
+	SourceFile             types.String   `tfsdk:"source_file"`
+	SourceSHA256           types.String   `tfsdk:"source_sha256"`
+	StoreBody              types.Bool     `tfsdk:"store_body"`
+	SyncUnique             types.String   `tfsdk:"sync_unique"`
+	TemplateVars           types.Map      `tfsdk:"template_vars"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+	Title                  types.String   `tfsdk:"title"`
+	Type                   types.String   `tfsdk:"type"`
+	UpdatedAt              types.String   `tfsdk:"updated_at"`
+	UploadImages           types.Bool     `tfsdk:"upload_images"`
+	UploadedImages         types.Map      `tfsdk:"uploaded_images"`
+	User                   types.String   `tfsdk:"user"`
+	UseSlug                types.String   `tfsdk:"use_slug"`
+	VerifyParentDoc        types.Bool     `tfsdk:"verify_parent_doc"`
+	Version                types.String   `tfsdk:"version"`
+	VersionID              types.String   `tfsdk:"version_id"`
+	WaitForSearchIndex     types.Bool     `tfsdk:"wait_for_search_index"`
 }
 
 // docMetadata represents the metadata field in the doc schema.
@@ -74,44 +90,75 @@ func docModelValue(ctx context.Context, doc readme.Doc, model docModel) docModel
 		model.ParentDocSlug = types.StringValue("")
 	}
 
+	if model.UploadedImages.IsNull() || model.UploadedImages.IsUnknown() {
+		model.UploadedImages = types.MapNull(types.StringType)
+	}
+
+	if model.TemplateVars.IsNull() || model.TemplateVars.IsUnknown() {
+		model.TemplateVars = types.MapNull(types.StringType)
+	}
+
+	// Only store a checksum of the body when the body isn't stored.
+	bodyClean := types.StringValue(doc.Body)
+	bodyHTML := types.StringValue(doc.BodyHTML)
+	if storeBodyDisabled(model.SourceFile, model.StoreBody) {
+		bodyClean = types.StringValue(bodyHash(doc.Body))
+		bodyHTML = types.StringNull()
+	}
+
 	return docModel{
-		Algolia:         docModelAlgoliaValue(doc.Algolia),
-		API:             docModelAPIValue(doc.API),
-		Body:            model.Body,
-		BodyClean:       types.StringValue(doc.Body),
-		BodyHTML:        types.StringValue(doc.BodyHTML),
-		Category:        types.StringValue(doc.Category),
-		CategorySlug:    model.CategorySlug,
-		CreatedAt:       types.StringValue(doc.CreatedAt),
-		Deprecated:      types.BoolValue(doc.Deprecated),
-		Error:           docModelErrorValue(doc.Error),
-		Excerpt:         types.StringValue(doc.Excerpt),
-		Hidden:          types.BoolValue(doc.Hidden),
-		ID:              types.StringValue(doc.ID),
-		Icon:            types.StringValue(doc.Icon),
-		IsAPI:           types.BoolValue(doc.IsAPI),
-		IsReference:     types.BoolValue(doc.IsReference),
-		LinkExternal:    types.BoolValue(doc.LinkExternal),
-		LinkURL:         types.StringValue(doc.LinkURL),
-		Metadata:        docModelMetadataValue(doc.Metadata),
-		Next:            docModelNextValue(doc.Next),
-		Order:           types.Int64Value(int64(doc.Order)),
-		ParentDoc:       types.StringValue(doc.ParentDoc),
-		ParentDocSlug:   model.ParentDocSlug,
-		PreviousSlug:    types.StringValue(doc.PreviousSlug),
-		Project:         types.StringValue(doc.Project),
-		Revision:        types.Int64Value(int64(doc.Revision)),
-		Slug:            types.StringValue(doc.Slug),
-		SlugUpdatedAt:   types.StringValue(doc.SlugUpdatedAt),
-		SyncUnique:      types.StringValue(doc.SyncUnique),
-		Title:           types.StringValue(doc.Title),
-		Type:            types.StringValue(doc.Type),
-		UpdatedAt:       types.StringValue(doc.UpdatedAt),
-		User:            types.StringValue(doc.User),
-		UseSlug:         model.UseSlug,
-		VerifyParentDoc: model.VerifyParentDoc,
-		Version:         model.Version,
-		VersionID:       types.StringValue(doc.Version),
+		Algolia:                docModelAlgoliaValue(doc.Algolia),
+		API:                    docModelAPIValue(doc.API),
+		Body:                   model.Body,
+		BodyClean:              bodyClean,
+		BodyFormat:             model.BodyFormat,
+		BodyHTML:               bodyHTML,
+		Category:               types.StringValue(doc.Category),
+		CategorySlug:           model.CategorySlug,
+		CreatedAt:              types.StringValue(doc.CreatedAt),
+		Deprecated:             types.BoolValue(doc.Deprecated),
+		Error:                  docModelErrorValue(doc.Error),
+		Excerpt:                types.StringValue(doc.Excerpt),
+		FrontMatterFormat:      model.FrontMatterFormat,
+		FrontMatterMode:        model.FrontMatterMode,
+		FrontMatterPassthrough: model.FrontMatterPassthrough,
+		Hidden:                 types.BoolValue(doc.Hidden),
+		ID:                     types.StringValue(doc.ID),
+		Icon:                   types.StringValue(doc.Icon),
+		ImageBaseDir:           model.ImageBaseDir,
+		IsAPI:                  types.BoolValue(doc.IsAPI),
+		IsReference:            types.BoolValue(doc.IsReference),
+		LinkExternal:           types.BoolValue(doc.LinkExternal),
+		LinkCheck:              model.LinkCheck,
+		LinkURL:                types.StringValue(doc.LinkURL),
+		Metadata:               docModelMetadataValue(doc.Metadata),
+		Next:                   docModelNextValue(doc.Next),
+		Order:                  types.Int64Value(int64(doc.Order)),
+		ParentDoc:              types.StringValue(doc.ParentDoc),
+		ParentDocSlug:          model.ParentDocSlug,
+		PreviousSlug:           types.StringValue(doc.PreviousSlug),
+		Project:                types.StringValue(doc.Project),
+		Revision:               types.Int64Value(int64(doc.Revision)),
+		SearchIndexTimeout:     model.SearchIndexTimeout,
+		Slug:                   types.StringValue(doc.Slug),
+		SlugUpdatedAt:          types.StringValue(doc.SlugUpdatedAt),
+		SourceFile:             model.SourceFile,
+		SourceSHA256:           model.SourceSHA256,
+		StoreBody:              model.StoreBody,
+		SyncUnique:             types.StringValue(doc.SyncUnique),
+		TemplateVars:           model.TemplateVars,
+		Timeouts:               model.Timeouts,
+		Title:                  types.StringValue(doc.Title),
+		Type:                   types.StringValue(doc.Type),
+		UpdatedAt:              types.StringValue(doc.UpdatedAt),
+		UploadImages:           model.UploadImages,
+		UploadedImages:         model.UploadedImages,
+		User:                   types.StringValue(doc.User),
+		UseSlug:                model.UseSlug,
+		VerifyParentDoc:        model.VerifyParentDoc,
+		Version:                model.Version,
+		VersionID:              types.StringValue(doc.Version),
+		WaitForSearchIndex:     model.WaitForSearchIndex,
 	}
 }
 
@@ -134,7 +181,8 @@ func getDoc(
 	// Get the doc from ReadMe.
 	response, apiResponse, err := client.Doc.Get(slug, options)
 	if err != nil {
-		return state, apiResponse, fmt.Errorf(clientError(err, apiResponse))
+		// Return the model unchanged so the caller can retry the request with it, such as by ID.
+		return model, apiResponse, fmt.Errorf(clientError(err, apiResponse))
 	}
 
 	// Map the API object to the Terraform model.
@@ -404,7 +452,7 @@ func docModelMetadataValue(metadata readme.DocMetadata) basetypes.ObjectValue {
 	}
 
 	// Return a null object if the metadata is empty.
-	if metadata.Description == "" {
+	if metadata.Description == "" && metadata.Title == "" && len(metadata.Image) == 0 {
 		return types.ObjectNull(metadataTypes)
 	}
 
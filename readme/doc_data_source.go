@@ -36,32 +36,161 @@ func (d *docDataSource) Metadata(
 	resp.TypeName = req.ProviderTypeName + "_doc"
 }
 
+// docDataSourceModel maps the doc data source schema data. It's a subset of the doc resource model without the
+// attributes that only configure how the resource publishes a doc.
+type docDataSourceModel struct {
+	Algolia         types.Object `tfsdk:"algolia"`
+	API             types.Object `tfsdk:"api"`
+	Body            types.String `tfsdk:"body"`
+	BodyClean       types.String `tfsdk:"body_clean"`
+	BodyHTML        types.String `tfsdk:"body_html"`
+	Category        types.String `tfsdk:"category"`
+	CategorySlug    types.String `tfsdk:"category_slug"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	Deprecated      types.Bool   `tfsdk:"deprecated"`
+	Excerpt         types.String `tfsdk:"excerpt"`
+	Hidden          types.Bool   `tfsdk:"hidden"`
+	ID              types.String `tfsdk:"id"`
+	Icon            types.String `tfsdk:"icon"`
+	IsAPI           types.Bool   `tfsdk:"is_api"`
+	IsReference     types.Bool   `tfsdk:"is_reference"`
+	LinkExternal    types.Bool   `tfsdk:"link_external"`
+	LinkURL         types.String `tfsdk:"link_url"`
+	Error           types.Object `tfsdk:"error"`
+	Metadata        types.Object `tfsdk:"metadata"`
+	Next            types.Object `tfsdk:"next"`
+	ParentDoc       types.String `tfsdk:"parent_doc"`
+	ParentDocSlug   types.String `tfsdk:"parent_doc_slug"`
+	Order           types.Int64  `tfsdk:"order"`
+	PreviousSlug    types.String `tfsdk:"previous_slug"`
+	Project         types.String `tfsdk:"project"`
+	Revision        types.Int64  `tfsdk:"revision"`
+	Slug            types.String `tfsdk:"slug"`
+	SlugUpdatedAt   types.String `tfsdk:"slug_updated_at"`
+	SyncUnique      types.String `tfsdk:"sync_unique"`
+	Title           types.String `tfsdk:"title"`
+	Type            types.String `tfsdk:"type"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	User            types.String `tfsdk:"user"`
+	UseSlug         types.String `tfsdk:"use_slug"`
+	VerifyParentDoc types.Bool   `tfsdk:"verify_parent_doc"`
+	Version         types.String `tfsdk:"version"`
+	VersionID       types.String `tfsdk:"version_id"`
+}
+
+// docModel returns the doc model for retrieving the doc with the attributes of the data source.
+func (m docDataSourceModel) docModel() docModel {
+	return docModel{
+		Algolia:         m.Algolia,
+		API:             m.API,
+		Body:            m.Body,
+		BodyClean:       m.BodyClean,
+		BodyHTML:        m.BodyHTML,
+		Category:        m.Category,
+		CategorySlug:    m.CategorySlug,
+		CreatedAt:       m.CreatedAt,
+		Deprecated:      m.Deprecated,
+		Excerpt:         m.Excerpt,
+		Hidden:          m.Hidden,
+		ID:              m.ID,
+		Icon:            m.Icon,
+		IsAPI:           m.IsAPI,
+		IsReference:     m.IsReference,
+		LinkExternal:    m.LinkExternal,
+		LinkURL:         m.LinkURL,
+		Error:           m.Error,
+		Metadata:        m.Metadata,
+		Next:            m.Next,
+		ParentDoc:       m.ParentDoc,
+		ParentDocSlug:   m.ParentDocSlug,
+		Order:           m.Order,
+		PreviousSlug:    m.PreviousSlug,
+		Project:         m.Project,
+		Revision:        m.Revision,
+		Slug:            m.Slug,
+		SlugUpdatedAt:   m.SlugUpdatedAt,
+		SyncUnique:      m.SyncUnique,
+		Title:           m.Title,
+		Type:            m.Type,
+		UpdatedAt:       m.UpdatedAt,
+		User:            m.User,
+		UseSlug:         m.UseSlug,
+		VerifyParentDoc: m.VerifyParentDoc,
+		Version:         m.Version,
+		VersionID:       m.VersionID,
+	}
+}
+
+// docDataSourceModelValue returns the data source model of a retrieved doc.
+func docDataSourceModelValue(model docModel) docDataSourceModel {
+	return docDataSourceModel{
+		Algolia:         model.Algolia,
+		API:             model.API,
+		Body:            model.Body,
+		BodyClean:       model.BodyClean,
+		BodyHTML:        model.BodyHTML,
+		Category:        model.Category,
+		CategorySlug:    model.CategorySlug,
+		CreatedAt:       model.CreatedAt,
+		Deprecated:      model.Deprecated,
+		Excerpt:         model.Excerpt,
+		Hidden:          model.Hidden,
+		ID:              model.ID,
+		Icon:            model.Icon,
+		IsAPI:           model.IsAPI,
+		IsReference:     model.IsReference,
+		LinkExternal:    model.LinkExternal,
+		LinkURL:         model.LinkURL,
+		Error:           model.Error,
+		Metadata:        model.Metadata,
+		Next:            model.Next,
+		ParentDoc:       model.ParentDoc,
+		ParentDocSlug:   model.ParentDocSlug,
+		Order:           model.Order,
+		PreviousSlug:    model.PreviousSlug,
+		Project:         model.Project,
+		Revision:        model.Revision,
+		Slug:            model.Slug,
+		SlugUpdatedAt:   model.SlugUpdatedAt,
+		SyncUnique:      model.SyncUnique,
+		Title:           model.Title,
+		Type:            model.Type,
+		UpdatedAt:       model.UpdatedAt,
+		User:            model.User,
+		UseSlug:         model.UseSlug,
+		VerifyParentDoc: model.VerifyParentDoc,
+		Version:         model.Version,
+		VersionID:       model.VersionID,
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *docDataSource) Read(
 	ctx context.Context,
 	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	var state docModel
+	var config docDataSourceModel
 
 	// Get config.
-	diags := req.Config.Get(ctx, &state)
+	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	requestOpts := apiRequestOptions(state.Version)
+	requestOpts := apiRequestOptions(config.Version)
 	tflog.Info(ctx, fmt.Sprintf("retrieving doc with request options=%+v", requestOpts))
 
 	// Get the doc.
-	state, _, err := getDoc(d.client, ctx, state.Slug.ValueString(), state, requestOpts)
+	doc, _, err := getDoc(d.client, ctx, config.Slug.ValueString(), config.docModel(), requestOpts)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to retrieve doc metadata.", err.Error())
 
 		return
 	}
 
+	state := docDataSourceModelValue(doc)
 	state.Body = state.BodyClean
 
 	// Set state.
@@ -376,10 +505,8 @@ func (d *docDataSource) Schema(
 				Description: "The ID of the author of the doc in the web editor.",
 				Computed:    true,
 			},
-			// This isn't used by the doc data source, but must be present because the struct
-			// is shared with the doc resource, which does use it.
-			// In the future, we may want to split the struct into separate types for the
-			// resource and data source.
+			// This isn't used by the doc data source. It was present while the data source shared
+			// the doc resource's model and is kept so configurations that reference it still work.
 			"use_slug": schema.StringAttribute{
 				Description: "This is an unused attribute in the data source that is present to " +
 					"satisfy the model shared with the doc resource. It may be removed in the future.",
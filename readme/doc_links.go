@@ -0,0 +1,180 @@
+package readme
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+const (
+	// linkCheckOff disables internal link validation.
+	linkCheckOff = "off"
+
+	// linkCheckWarn reports broken internal links as warnings.
+	linkCheckWarn = "warn"
+
+	// linkCheckError reports broken internal links as errors.
+	linkCheckError = "error"
+)
+
+var (
+	// linkDestinationRegexp matches the destinations of Markdown inline links, link reference definitions, and
+	// HTML anchors. The destination is in the first non-empty submatch.
+	linkDestinationRegexp = regexp.MustCompile(
+		`\]\(\s*<?([^)\s>]+)|^ {0,3}\[[^\]]+\]:\s*(\S+)|<a\s[^>]*?href=["']([^"']+)`,
+	)
+
+	// internalLinkRegexp matches an internal link destination and captures the linked doc's slug.
+	internalLinkRegexp = regexp.MustCompile(`^(?:doc:|ref:|/docs/)([A-Za-z0-9_.-]+)/?(?:[?#].*)?$`)
+
+	// codeFenceRegexp matches the opening or closing line of a fenced code block.
+	codeFenceRegexp = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+)
+
+// internalLink is a link in a doc body to another doc in the project.
+type internalLink struct {
+	// Link is the link destination as it's written in the body.
+	Link string
+	// Slug is the slug of the linked doc.
+	Slug string
+}
+
+// linkCheckSchema returns the resource schema attribute for validating the internal links in a doc body.
+func linkCheckSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"link_check": schema.StringAttribute{
+			Description: "Validate the internal links in the body during the plan. Links to other docs using " +
+				"`doc:<slug>`, `ref:<slug>`, and `/docs/<slug>` are checked against the docs in the same version. " +
+				"Set to `warn` to report broken links as warnings or `error` to fail the plan. Links to docs that " +
+				"are created in the same apply are reported as broken. Must be one of `off`, `warn`, or `error`. " +
+				"Defaults to `off`.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(linkCheckOff),
+		},
+	}
+}
+
+// validateLinkCheck returns an error diagnostic if the link check mode is not a supported value.
+func validateLinkCheck(mode types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if mode.IsNull() || mode.IsUnknown() {
+		return diags
+	}
+
+	switch mode.ValueString() {
+	case linkCheckOff, linkCheckWarn, linkCheckError:
+	default:
+		diags.AddAttributeError(
+			path.Root("link_check"),
+			"Invalid link check mode.",
+			fmt.Sprintf("link_check must be one of '%s', '%s', or '%s', got '%s'.",
+				linkCheckOff, linkCheckWarn, linkCheckError, mode.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// internalLinks returns the links to other docs in a body. Links in fenced code blocks are ignored.
+// A link that appears more than once is only returned once.
+func internalLinks(body string) []internalLink {
+	links := []internalLink{}
+	seen := map[string]bool{}
+	fence := ""
+
+	for _, line := range strings.Split(body, "\n") {
+		if match := codeFenceRegexp.FindStringSubmatch(line); match != nil {
+			switch {
+			case fence == "":
+				fence = match[1]
+			case strings.HasPrefix(match[1], fence):
+				fence = ""
+			}
+
+			continue
+		}
+
+		if fence != "" {
+			continue
+		}
+
+		for _, match := range linkDestinationRegexp.FindAllStringSubmatch(line, -1) {
+			destination := match[1] + match[2] + match[3]
+
+			slug := internalLinkRegexp.FindStringSubmatch(destination)
+			if slug == nil || seen[destination] {
+				continue
+			}
+
+			seen[destination] = true
+			links = append(links, internalLink{Link: destination, Slug: slug[1]})
+		}
+	}
+
+	return links
+}
+
+// brokenLinks returns the links whose slugs are not in the list of known doc slugs.
+func brokenLinks(links []internalLink, slugs []string) []internalLink {
+	known := map[string]bool{}
+	for _, slug := range slugs {
+		known[slug] = true
+	}
+
+	broken := []internalLink{}
+
+	for _, link := range links {
+		if !known[link.Slug] {
+			broken = append(broken, link)
+		}
+	}
+
+	return broken
+}
+
+// versionDocSlugs returns the slugs of every doc in a version, including child docs, in category order.
+// The docs are retrieved from each category in the version, as in the readme_category_docs data source.
+func versionDocSlugs(
+	client *readme.Client,
+	options readme.RequestOptions,
+) ([]string, *readme.APIResponse, error) {
+	categories, apiResponse, err := client.Category.GetAll(options)
+	if err != nil {
+		return nil, apiResponse, fmt.Errorf("unable to retrieve categories: %w", err)
+	}
+
+	slugs := []string{}
+
+	for _, category := range categories {
+		docs, apiResponse, err := categoryDocSlugs(client, category.Slug, options)
+		if err != nil {
+			return nil, apiResponse, fmt.Errorf("unable to retrieve docs in category %s: %w", category.Slug, err)
+		}
+
+		slugs = append(slugs, docs...)
+	}
+
+	return slugs, apiResponse, nil
+}
+
+// brokenLinksSummary returns a sorted, newline-separated list of broken links for a diagnostic message.
+func brokenLinksSummary(links []internalLink) string {
+	lines := []string{}
+	for _, link := range links {
+		lines = append(lines, fmt.Sprintf("  - %s (doc '%s' not found)", link.Link, link.Slug))
+	}
+
+	sort.Strings(lines)
+
+	return strings.Join(lines, "\n")
+}
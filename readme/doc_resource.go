@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,11 +29,32 @@ var (
 	_ resource.Resource                = &docResource{}
 	_ resource.ResourceWithConfigure   = &docResource{}
 	_ resource.ResourceWithImportState = &docResource{}
+	_ resource.ResourceWithModifyPlan  = &docResource{}
 )
 
+// docFrontMatterKeys maps the front matter keys supported by the readme_doc resource to the attributes they set.
+var docFrontMatterKeys = map[string]string{
+	"body":          "body",
+	"category":      "category",
+	"categorySlug":  "category_slug",
+	"deprecated":    "deprecated",
+	"error":         "error",
+	"excerpt":       "excerpt",
+	"hidden":        "hidden",
+	"metadata":      "metadata",
+	"next":          "next",
+	"order":         "order",
+	"parentDoc":     "parent_doc",
+	"parentDocSlug": "parent_doc_slug",
+	"slug":          "slug",
+	"title":         "title",
+	"type":          "type",
+}
+
 // docResource is the data source implementation.
 type docResource struct {
-	client *readme.Client
+	client          *readme.Client
+	frontMatterMode string
 }
 
 // NewDocResource is a helper function to simplify the provider implementation.
@@ -59,7 +81,14 @@ func (r *docResource) Configure(
 		return
 	}
 
-	r.client = req.ProviderData.(*readme.Client)
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.frontMatterMode = data.frontMatterMode
+}
+
+// frontMatterSettings returns the front matter settings for a doc plan.
+func (r *docResource) frontMatterSettings(plan docModel) frontMatterSettings {
+	return newFrontMatterSettings(plan.FrontMatterFormat, plan.FrontMatterMode, plan.FrontMatterPassthrough, r.frontMatterMode)
 }
 
 // ValidateConfig is used for validating attribute values.
@@ -70,11 +99,28 @@ func (r docResource) ValidateConfig(
 ) {
 	var data docModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	resp.Diagnostics.Append(validateBodyFormat(data.BodyFormat)...)
+	resp.Diagnostics.Append(validateFrontMatterFormat(data.FrontMatterFormat)...)
+	resp.Diagnostics.Append(validateFrontMatterMode(data.FrontMatterMode)...)
+	resp.Diagnostics.Append(validateLinkCheck(data.LinkCheck)...)
+	resp.Diagnostics.Append(validateSearchIndexTimeout(data.SearchIndexTimeout)...)
+
+	body, diags := configBody(data.Body, data.SourceFile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Front matter can't be checked until the template variables are known.
+	vars, known := templateVars(ctx, data.TemplateVars)
+	if !known {
+		return
+	}
 
 	// category or category_slug must be set. If the attributes aren't set, check the body front matter.
 	if data.Category.IsNull() && data.CategorySlug.IsNull() {
 		// check front matter for 'category'.
-		categoryMatter, diag := frontmatter.GetValue(ctx, data.Body.ValueString(), "Category")
+		categoryMatter, diag := frontmatter.GetValue(ctx, body, "Category", vars, data.FrontMatterFormat.ValueString())
 		if diag != "" {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("category"),
@@ -88,8 +134,10 @@ func (r docResource) ValidateConfig(
 		// check front matter for 'category_slug'.
 		categorySlugMatter, diag := frontmatter.GetValue(
 			ctx,
-			data.Body.ValueString(),
+			body,
 			"CategorySlug",
+			vars,
+			data.FrontMatterFormat.ValueString(),
 		)
 		if diag != "" {
 			resp.Diagnostics.AddAttributeError(
@@ -114,6 +162,57 @@ func (r docResource) ValidateConfig(
 	}
 }
 
+// checkLinks validates the links in a doc body to other docs in the same version.
+// Broken links are reported as warnings or errors depending on the `link_check` attribute.
+//
+// The `version` parameter is the configured version. The docs in the stable version are used when it's null.
+func (r *docResource) checkLinks(plan *docModel, version types.String, body string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	mode := plan.LinkCheck.ValueString()
+	if mode == "" || mode == linkCheckOff || version.IsUnknown() || r.client == nil {
+		return diags
+	}
+
+	links := internalLinks(body)
+	if len(links) == 0 {
+		return diags
+	}
+
+	slugs, apiResponse, err := versionDocSlugs(r.client, apiRequestOptions(version))
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("link_check"),
+			"Unable to check links.",
+			"There was a problem retrieving the docs in the version.\n"+clientError(err, apiResponse),
+		)
+
+		return diags
+	}
+
+	// The doc can link to itself.
+	if plan.Slug.ValueString() != "" {
+		slugs = append(slugs, plan.Slug.ValueString())
+	}
+
+	broken := brokenLinks(links, slugs)
+	if len(broken) == 0 {
+		return diags
+	}
+
+	summary := "Broken internal links."
+	detail := fmt.Sprintf("The body links to %d doc(s) that don't exist in the version:\n%s",
+		len(broken), brokenLinksSummary(broken))
+
+	if mode == linkCheckError {
+		diags.AddAttributeError(path.Root("body"), summary, detail)
+	} else {
+		diags.AddAttributeWarning(path.Root("body"), summary, detail)
+	}
+
+	return diags
+}
+
 func (r *docResource) ModifyPlan(
 	ctx context.Context,
 	req resource.ModifyPlanRequest,
@@ -129,6 +228,93 @@ func (r *docResource) ModifyPlan(
 		return
 	}
 
+	// Read the body from the source file if one is set.
+	var stateBody, stateSHA256 types.String
+	if state != nil {
+		stateBody, stateSHA256 = state.Body, state.SourceSHA256
+	}
+
+	source, diags := planSourceFile(plan.Body, plan.SourceFile, plan.StoreBody, stateBody, stateSHA256)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Body = source.Body
+	plan.SourceSHA256 = source.SHA256
+
+	// Render the body as a template.
+	content, rendered, diags := planTemplate(ctx, source.Content, plan.TemplateVars, plan.SourceFile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate the front matter keys.
+	if rendered {
+		var config docModel
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		resp.Diagnostics.Append(checkFrontMatter(
+			content,
+			r.frontMatterSettings(*plan),
+			plan.SourceFile,
+			docFrontMatterKeys,
+			configuredAttributes(map[string]attr.Value{
+				"category":        config.Category,
+				"category_slug":   config.CategorySlug,
+				"error":           config.Error,
+				"hidden":          config.Hidden,
+				"order":           config.Order,
+				"parent_doc":      config.ParentDoc,
+				"parent_doc_slug": config.ParentDocSlug,
+				"title":           config.Title,
+				"type":            config.Type,
+			}),
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Expand the include directives in the body.
+	content, diags = planIncludes(content, plan.SourceFile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Rewrite references to local images that have already been uploaded.
+	var stateUploaded types.Map
+	if state != nil {
+		stateUploaded = state.UploadedImages
+	}
+
+	content = formatBody(content, plan.BodyFormat)
+
+	images, diags := planImages(
+		ctx,
+		content,
+		plan.UploadImages,
+		imageBaseDir(plan.ImageBaseDir, plan.SourceFile),
+		stateUploaded,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.UploadedImages = images.Uploaded
+
+	// Validate the links to other docs.
+	if !plan.SourceFile.IsUnknown() && rendered {
+		var version types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version"), &version)...)
+		resp.Diagnostics.Append(r.checkLinks(plan, version, content)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if state == nil {
 		plan.BodyClean = types.StringUnknown()
 		plan.BodyHTML = types.StringUnknown()
@@ -141,13 +327,23 @@ func (r *docResource) ModifyPlan(
 		return
 	}
 
-	body := strings.TrimSpace(plan.Body.ValueString())
+	// The front matter isn't published, so it isn't compared against the API response.
+	body := strings.TrimSpace(frontmatter.Strip(images.Body, plan.FrontMatterFormat.ValueString()))
 
 	// Expand newline escape sequences.
 	body = strings.ReplaceAll(body, `\n`, "\n")
 	plan.BodyClean = types.StringValue(body)
 
-	diags := resp.Plan.Set(ctx, plan)
+	// Only a checksum of the body is stored when the body isn't stored.
+	if storeBodyDisabled(plan.SourceFile, plan.StoreBody) {
+		plan.BodyClean = types.StringValue(bodyHash(body))
+	}
+
+	if plan.SourceFile.IsUnknown() || !images.Known || !rendered {
+		plan.BodyClean = types.StringUnknown()
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 
 	// The 'algolia', 'revision', 'updated_at', and 'user' attributes are
@@ -170,6 +366,12 @@ func (r *docResource) ModifyPlan(
 		plan.UpdatedAt = types.StringUnknown()
 		plan.User = types.StringUnknown()
 
+		// A change to the source file doesn't change the configuration, so the rendered HTML isn't
+		// otherwise refreshed.
+		if !state.BodyClean.Equal(plan.BodyClean) && !storeBodyDisabled(plan.SourceFile, plan.StoreBody) {
+			plan.BodyHTML = types.StringUnknown()
+		}
+
 		plan.Algolia = types.ObjectUnknown(
 			map[string]attr.Type{
 				"record_count":    types.Int64Type,
@@ -229,9 +431,47 @@ func (r *docResource) Create(
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Create, operationCreate, &resp.Diagnostics)
+	defer done()
+
 	requestOpts := apiRequestOptions(plan.Version)
 	tflog.Info(ctx, fmt.Sprintf("creating doc with request options=%+v", requestOpts))
 
+	// Resolve the body from the source file, if set.
+	body, err := sourceFileBody(plan.Body, plan.SourceFile, plan.SourceSHA256)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create doc.", err.Error())
+
+		return
+	}
+
+	body, err = renderBody(ctx, body, plan.TemplateVars)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create doc.", err.Error())
+
+		return
+	}
+
+	body, err = expandIncludes(body, includeBaseDir(plan.SourceFile))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create doc.", err.Error())
+
+		return
+	}
+
+	body = formatBody(body, plan.BodyFormat)
+
+	// Upload local images referenced in the body.
+	if plan.UploadImages.ValueBool() {
+		baseDir := imageBaseDir(plan.ImageBaseDir, plan.SourceFile)
+		body, plan.UploadedImages, err = uploadImages(ctx, r.client, body, baseDir, types.MapNull(types.StringType))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to create doc.", err.Error())
+
+			return
+		}
+	}
+
 	// If a parent doc is set, verify that it exists.
 	if plan.VerifyParentDoc.IsNull() || plan.VerifyParentDoc.ValueBool() {
 		validParent, detail := r.docValidParent(ctx, plan, requestOpts)
@@ -244,7 +484,9 @@ func (r *docResource) Create(
 
 	if plan.UseSlug.IsNull() {
 		// Create the doc.
-		doc, apiResponse, err = r.client.Doc.Create(docPlanToParams(ctx, plan), requestOpts)
+		params := docPlanToParams(ctx, plan)
+		params.Body = body
+		doc, apiResponse, err = saveDoc(r.client, "", params, r.frontMatterSettings(plan), requestOpts)
 		if err != nil {
 			resp.Diagnostics.AddError("Unable to create doc.", clientError(err, apiResponse))
 
@@ -252,7 +494,7 @@ func (r *docResource) Create(
 		}
 	} else {
 		// Adopt the doc.
-		adopted, err := r.adoptDoc(ctx, plan, requestOpts)
+		adopted, err := r.adoptDoc(ctx, plan, body, requestOpts)
 		if err != nil {
 			resp.Diagnostics.AddError("Unable to create doc.", err.Error())
 
@@ -288,6 +530,9 @@ func (r *docResource) Create(
 		return
 	}
 
+	state, diags = r.waitForSearchIndex(ctx, state, requestOpts)
+	resp.Diagnostics.Append(diags...)
+
 	// Set state to fully populated data.
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -299,11 +544,33 @@ func (r *docResource) Create(
 	}
 }
 
+// waitForSearchIndex waits for a saved doc to be indexed for search when `wait_for_search_index` is true.
+func (r *docResource) waitForSearchIndex(
+	ctx context.Context,
+	state docModel,
+	requestOpts readme.RequestOptions,
+) (docModel, diag.Diagnostics) {
+	return waitForSearchIndex(
+		ctx,
+		state.WaitForSearchIndex,
+		state.SearchIndexTimeout,
+		fmt.Sprintf("doc '%s'", state.Slug.ValueString()),
+		state,
+		func(model docModel) types.Object { return model.Algolia },
+		func() (docModel, error) {
+			model, _, err := getDoc(r.client, ctx, state.Slug.ValueString(), state, requestOpts)
+
+			return model, err
+		},
+	)
+}
+
 // adoptDoc attempts to retrieve a doc by its slug and update it with the plan attributes.
 // This is used when the `use_slug` attribute is set to assume management of an existing doc.
 func (r *docResource) adoptDoc(
 	ctx context.Context,
 	plan docModel,
+	body string,
 	requestOpts readme.RequestOptions,
 ) (*readme.Doc, error) {
 	slug := plan.UseSlug.ValueString()
@@ -319,7 +586,9 @@ func (r *docResource) adoptDoc(
 
 	// Update the existing doc.
 	tflog.Info(ctx, fmt.Sprintf("updating doc %s", slug))
-	doc, _, err := r.client.Doc.Update(slug, docPlanToParams(ctx, plan), requestOpts)
+	params := docPlanToParams(ctx, plan)
+	params.Body = body
+	doc, _, err := saveDoc(r.client, slug, params, r.frontMatterSettings(plan), requestOpts)
 	if err != nil {
 		return nil, fmt.Errorf("error updating doc %s: %w", slug, err)
 	}
@@ -341,6 +610,9 @@ func (r *docResource) Read(
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Read, operationRead, &resp.Diagnostics)
+	defer done()
+
 	requestOpts := apiRequestOptions(state.Version)
 	logMsg := fmt.Sprintf("retrieving doc %s with request options=%+v", state.Slug.ValueString(), requestOpts)
 	tflog.Info(ctx, logMsg)
@@ -348,6 +620,21 @@ func (r *docResource) Read(
 	slug := state.Slug.ValueString()
 	stateID := state.ID.ValueString()
 
+	// Imported docs don't have a value for body_format, link_check, store_body, or upload_images.
+	state.BodyFormat = bodyFormatValue(state.BodyFormat)
+
+	if state.LinkCheck.IsNull() {
+		state.LinkCheck = types.StringValue(linkCheckOff)
+	}
+
+	if state.StoreBody.IsNull() {
+		state.StoreBody = types.BoolValue(true)
+	}
+
+	if state.UploadImages.IsNull() {
+		state.UploadImages = types.BoolValue(false)
+	}
+
 	if state.UseSlug.ValueString() != "" {
 		tflog.Info(ctx, fmt.Sprintf("use_slug is set to %s.", state.UseSlug.ValueString()))
 		slug = state.UseSlug.ValueString()
@@ -411,6 +698,9 @@ func (r *docResource) Update(
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Update, operationUpdate, &resp.Diagnostics)
+	defer done()
+
 	requestOpts := apiRequestOptions(plan.Version)
 	tflog.Info(ctx, fmt.Sprintf("updating doc with request options=%+v", requestOpts))
 
@@ -430,9 +720,45 @@ func (r *docResource) Update(
 		slug = state.UseSlug.ValueString()
 	}
 
+	// Resolve the body from the source file, if set.
+	body, err := sourceFileBody(plan.Body, plan.SourceFile, plan.SourceSHA256)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update doc.", err.Error())
+
+		return
+	}
+
+	body, err = renderBody(ctx, body, plan.TemplateVars)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update doc.", err.Error())
+
+		return
+	}
+
+	body, err = expandIncludes(body, includeBaseDir(plan.SourceFile))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update doc.", err.Error())
+
+		return
+	}
+
+	body = formatBody(body, plan.BodyFormat)
+
+	// Upload local images referenced in the body.
+	if plan.UploadImages.ValueBool() {
+		baseDir := imageBaseDir(plan.ImageBaseDir, plan.SourceFile)
+		body, plan.UploadedImages, err = uploadImages(ctx, r.client, body, baseDir, state.UploadedImages)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to update doc.", err.Error())
+
+			return
+		}
+	}
+
 	// Update the doc.
 	params := docPlanToParams(ctx, plan)
-	response, apiResponse, err := r.client.Doc.Update(slug, params, requestOpts)
+	params.Body = body
+	response, apiResponse, err := saveDoc(r.client, slug, params, r.frontMatterSettings(plan), requestOpts)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update doc.", clientError(err, apiResponse))
 
@@ -469,6 +795,9 @@ func (r *docResource) Update(
 		return
 	}
 
+	plan, diags := r.waitForSearchIndex(ctx, plan, requestOpts)
+	resp.Diagnostics.Append(diags...)
+
 	// Set refreshed state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -489,6 +818,9 @@ func (r *docResource) Delete(
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Delete, operationDelete, &resp.Diagnostics)
+	defer done()
+
 	if !state.UseSlug.IsNull() {
 		tflog.Info(ctx, fmt.Sprintf("use_slug is set to %s. Doc will not be "+
 			"deleted remotely but will be removed from state.", state.UseSlug.ValueString()))
@@ -554,14 +886,15 @@ func (r *docResource) docValidParent(
 
 // Schema for the readme_doc resource.
 func (r *docResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Manage docs on ReadMe.com\n\n" +
 			"Docs on ReadMe support setting some attributes using front matter. " +
-			"Resource attributes take precedence over front matter attributes in the provider.\n\n" +
+			"Resource attributes take precedence over front matter attributes in the provider. The front matter is " +
+			"removed from the body that is published.\n\n" +
 			"Refer to <https://docs.readme.com/main/docs/rdme> for more information about using front matter in " +
 			"ReadMe docs and custom pages.\n\n" +
 			"See <https://docs.readme.com/main/reference/getdoc> for more information about this API endpoint.",
@@ -686,7 +1019,8 @@ func (r *docResource) Schema(
 			"body": schema.StringAttribute{
 				Description: "The body content of the doc, formatted in ReadMe or GitHub flavored Markdown. " +
 					"Accepts long page content, for example, greater than 100k characters. " +
-					"Optionally use front matter to set certain attributes.",
+					"Optionally use front matter to set certain attributes. Cannot be used with `source_file`." +
+					includeDescription,
 				Computed: true,
 				Optional: true,
 			},
@@ -728,8 +1062,11 @@ func (r *docResource) Schema(
 				},
 			},
 			"deprecated": schema.BoolAttribute{
-				Description: "Identifies if a doc is deprecated or not.",
+				Description: "Identifies if a doc is deprecated or not. This attribute may be set in the body front matter.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					frontmatter.GetBool("Deprecated"),
+				},
 			},
 			"error": schema.SingleNestedAttribute{
 				Description: "Error code configuration for a doc. This attribute may be set in the body front matter.",
@@ -742,8 +1079,11 @@ func (r *docResource) Schema(
 				},
 			},
 			"excerpt": schema.StringAttribute{
-				Description: "A short summary of the content.",
+				Description: "A short summary of the content. This attribute may be set in the body front matter.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					frontmatter.GetString("Excerpt"),
+				},
 			},
 			"hidden": schema.BoolAttribute{
 				Description: "Toggles if a doc is hidden or not. This attribute may be set in the body front matter.",
@@ -786,8 +1126,12 @@ func (r *docResource) Schema(
 				Computed:    true,
 			},
 			"metadata": schema.SingleNestedAttribute{
-				Description: "Metadata about the doc.",
-				Computed:    true,
+				Description: "Metadata about the doc. This attribute may be set in the body front matter with the " +
+					"`title`, `description`, and `image` keys nested under the `metadata` key.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					frontmatter.GetObject("Metadata"),
+				},
 				Attributes: map[string]schema.Attribute{
 					"description": schema.StringAttribute{
 						Description: "The description of the doc.",
@@ -797,6 +1141,9 @@ func (r *docResource) Schema(
 						Description: "An image associated with the doc.",
 						Computed:    true,
 						ElementType: types.StringType,
+						PlanModifiers: []planmodifier.List{
+							frontmatter.GetList("Metadata.Image"),
+						},
 					},
 					"title": schema.StringAttribute{
 						Description: "The title of the doc.",
@@ -805,8 +1152,12 @@ func (r *docResource) Schema(
 				},
 			},
 			"next": schema.SingleNestedAttribute{
-				Description: "Information about the 'next' pages in a series.",
-				Computed:    true,
+				Description: "Information about the 'next' pages in a series. This attribute may be set in the body " +
+					"front matter with the `description` and `pages` keys nested under the `next` key.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					frontmatter.GetObject("Next"),
+				},
 				Attributes: map[string]schema.Attribute{
 					"description": schema.StringAttribute{
 						Computed: true,
@@ -814,6 +1165,9 @@ func (r *docResource) Schema(
 					"pages": schema.ListNestedAttribute{
 						Computed:    true,
 						Description: "List of 'next' page configurations.",
+						PlanModifiers: []planmodifier.List{
+							frontmatter.GetList("Next.Pages"),
+						},
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
@@ -887,8 +1241,11 @@ func (r *docResource) Schema(
 				Computed:    true,
 			},
 			"slug": schema.StringAttribute{
-				Description: "The slug of the doc.",
+				Description: "The slug of the doc. This attribute may be set in the body front matter.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					frontmatter.GetString("Slug"),
+				},
 			},
 			"slug_updated_at": schema.StringAttribute{
 				Description: "The timestamp of when the doc's slug was last updated.",
@@ -964,5 +1321,40 @@ func (r *docResource) Schema(
 				Default:  booldefault.StaticBool(true),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+
+	for name, attribute := range sourceFileSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range uploadImagesSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range bodyFormatSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range linkCheckSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range templateVarsSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range searchIndexSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range frontMatterSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+
+	for name, attribute := range frontMatterPassthroughSchema() {
+		resp.Schema.Attributes[name] = attribute
 	}
 }
@@ -3,6 +3,7 @@ package readme
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"strings"
 	"testing"
@@ -580,6 +581,10 @@ func TestDocResource_FrontMatter(t *testing.T) {
 				expect.Order = *testCase.expect.Order
 			}
 
+			// The front matter isn't published with the body.
+			published := expect
+			published.Body = "This is a document."
+
 			// Close all gocks after the test completes.
 			defer gock.OffAll()
 
@@ -602,13 +607,13 @@ func TestDocResource_FrontMatter(t *testing.T) {
 							gock.OffAll()
 							docCommonGocks()
 							// Mock the request to create the resource.
-							gock.New(testURL).Post("/docs").Times(3).Reply(201).JSON(expect)
+							gock.New(testURL).Post("/docs").Times(3).Reply(201).JSON(published)
 							// Mock the request to get and refresh the resource.
 							gock.New(testURL).
 								Get("/docs/" + mockDoc.Slug).
 								Persist().
 								Reply(200).
-								JSON(expect)
+								JSON(published)
 							// Mock the post-test delete.
 							gock.New(testURL).Delete("/docs/" + expect.Slug).Times(1).Reply(204)
 						},
@@ -804,7 +809,8 @@ func TestDocResource_Hidden_Attribute_Changes(t *testing.T) {
 					expectedDoc.Title, expectedDoc.Category, expectedDoc.Type,
 				),
 				PreConfig: func() {
-					expectedDoc.Body = "---\nhidden: false\n---\nbody"
+					// The front matter isn't published with the body.
+					expectedDoc.Body = "body"
 					expectedDoc.Hidden = false
 
 					docCommonGocks()
@@ -988,7 +994,8 @@ func TestDocResource_Order_Attribute_Changes(t *testing.T) {
 				),
 				PreConfig: func() {
 					gock.OffAll()
-					expectedDoc.Body = "---\norder: 3\n---\nbody"
+					// The front matter isn't published with the body.
+					expectedDoc.Body = "body"
 					expectedDoc.Order = 3
 
 					docCommonGocks()
@@ -1070,7 +1077,8 @@ func TestDocResource_Order_FrontMatter_Changes(t *testing.T) {
 	defer gock.OffAll()
 
 	expectedDoc := mockDoc
-	expectedDoc.Body = "---\norder: 1\n---\nbody"
+	// The front matter isn't published with the body.
+	expectedDoc.Body = "body"
 	expectedDoc.Order = 1
 
 	resource.Test(t, resource.TestCase{
@@ -1115,7 +1123,8 @@ func TestDocResource_Order_FrontMatter_Changes(t *testing.T) {
 				),
 				PreConfig: func() {
 					gock.OffAll()
-					expectedDoc.Body = "---\norder: 2\n---\nbody"
+					// The front matter isn't published with the body.
+					expectedDoc.Body = "body"
 					expectedDoc.Order = 2
 
 					docCommonGocks()
@@ -1247,3 +1256,725 @@ func TestDocRenamedSlugResource(t *testing.T) {
 		},
 	})
 }
+
+func TestDocResource_SourceFile(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	sourceFile := t.TempDir() + "/doc.md"
+	writeSourceFile := func(body string) {
+		if err := os.WriteFile(sourceFile, []byte(body), 0o600); err != nil {
+			t.Fatalf("unable to write source file: %s", err)
+		}
+	}
+
+	updatedDoc := mockDoc
+	updatedDoc.Body = "A turtle was here and left."
+
+	config := providerConfig + fmt.Sprintf(`
+		resource "readme_doc" "test" {
+			title       = "%s"
+			source_file = "%s"
+			store_body  = false
+			category    = "%s"
+			type        = "%s"
+		}`,
+		mockDoc.Title, sourceFile, mockDoc.Category, mockDoc.Type,
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test creating a doc without storing the body.
+			{
+				Config: config,
+				PreConfig: func() {
+					writeSourceFile(mockDoc.Body)
+					docCommonGocks()
+					gock.New(testURL).Post("/docs").Times(1).Reply(201).JSON(mockDoc)
+					gock.New(testURL).Get("/docs/" + mockDoc.Slug).Times(3).Reply(200).JSON(mockDoc)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("readme_doc.test", "body"),
+					resource.TestCheckNoResourceAttr("readme_doc.test", "body_html"),
+					resource.TestCheckResourceAttr("readme_doc.test", "body_clean", bodyHash(mockDoc.Body)),
+					resource.TestCheckResourceAttr("readme_doc.test", "source_sha256", sha256Hex(mockDoc.Body)),
+				),
+			},
+			// Test that a change to the source file updates the doc.
+			{
+				Config: config,
+				PreConfig: func() {
+					writeSourceFile(updatedDoc.Body)
+					gock.OffAll()
+					docCommonGocks()
+					gock.New(testURL).Get("/docs/" + mockDoc.Slug).Times(1).Reply(200).JSON(mockDoc)
+					gock.New(testURL).
+						Put("/docs/" + mockDoc.Slug).
+						MatchType("json").
+						BodyString(updatedDoc.Body).
+						Times(1).
+						Reply(200).
+						JSON(updatedDoc)
+					gock.New(testURL).Get("/docs/" + mockDoc.Slug).Persist().Reply(200).JSON(updatedDoc)
+					gock.New(testURL).Delete("/docs/" + mockDoc.Slug).Times(1).Reply(204)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_doc.test", "body_clean", bodyHash(updatedDoc.Body)),
+					resource.TestCheckResourceAttr(
+						"readme_doc.test",
+						"source_sha256",
+						sha256Hex(updatedDoc.Body),
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestDocResource_UploadImages(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	// mockImageResponse represents the response from the API when uploading an image.
+	mockImageResponse := []any{"https://files.readme.io/c6f07db-flow.png", "flow.png", 1, 1, "#000000"}
+	imageURL := mockImageResponse[0].(string)
+
+	// Write a doc that references the same image by two paths and as an HTML tag.
+	dir := t.TempDir()
+	image, err := os.ReadFile("../examples/resources/readme_image/example.png")
+	if err != nil {
+		t.Fatalf("unable to read image: %s", err)
+	}
+
+	for _, file := range []string{"/img/flow.png", "/img/copy.png"} {
+		if err := os.MkdirAll(dir+"/img", 0o700); err != nil {
+			t.Fatalf("unable to create image directory: %s", err)
+		}
+
+		if err := os.WriteFile(dir+file, image, 0o600); err != nil {
+			t.Fatalf("unable to write image: %s", err)
+		}
+	}
+
+	body := "![flow](./img/flow.png)\n\n![copy](img/copy.png \"Copy\")\n\n<img src=\"./img/flow.png\" />"
+	if err := os.WriteFile(dir+"/doc.md", []byte(body), 0o600); err != nil {
+		t.Fatalf("unable to write source file: %s", err)
+	}
+
+	uploadedDoc := mockDoc
+	uploadedDoc.Body = strings.NewReplacer("./img/flow.png", imageURL, "img/copy.png", imageURL).Replace(body)
+
+	config := providerConfig + fmt.Sprintf(`
+		resource "readme_doc" "test" {
+			title         = "%s"
+			source_file   = "%s/doc.md"
+			upload_images = true
+			category      = "%s"
+			type          = "%s"
+		}`,
+		mockDoc.Title, dir, mockDoc.Category, mockDoc.Type,
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that the image is uploaded once and the references are rewritten.
+			{
+				Config: config,
+				PreConfig: func() {
+					docCommonGocks()
+					gock.New("https://dash.readme.com/api/images").
+						Post("/image-upload").
+						Times(1).
+						Reply(200).
+						JSON(mockImageResponse)
+					gock.New(testURL).
+						Post("/docs").
+						MatchType("json").
+						BodyString(regexp.QuoteMeta(`![copy](` + imageURL + ` \"Copy\")`)).
+						Times(1).
+						Reply(201).
+						JSON(uploadedDoc)
+					gock.New(testURL).Get("/docs/" + mockDoc.Slug).Persist().Reply(200).JSON(uploadedDoc)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_doc.test", "body", body),
+					resource.TestCheckResourceAttr("readme_doc.test", "body_clean", uploadedDoc.Body),
+					resource.TestCheckResourceAttr("readme_doc.test", "uploaded_images.%", "1"),
+					resource.TestCheckResourceAttr(
+						"readme_doc.test",
+						"uploaded_images."+sha256Sum(image),
+						imageURL,
+					),
+				),
+			},
+			// Test that unchanged images aren't uploaded again.
+			{
+				Config:   config,
+				PlanOnly: true,
+				PreConfig: func() {
+					gock.OffAll()
+					docCommonGocks()
+					gock.New(testURL).Get("/docs/" + mockDoc.Slug).Persist().Reply(200).JSON(uploadedDoc)
+					gock.New(testURL).Delete("/docs/" + mockDoc.Slug).Times(1).Reply(204)
+				},
+			},
+		},
+	})
+}
+
+func TestDocResource_BodyFormat(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	body := "> [!NOTE]\n> See the [setup guide](./setup.md)."
+	convertedDoc := mockDoc
+	convertedDoc.Body = "> 📘 Note\n>\n> See the [setup guide](doc:setup)."
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test an invalid body format.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					resource "readme_doc" "test" {
+						title       = "%s"
+						body        = "%s"
+						body_format = "html"
+						category    = "%s"
+						type        = "%s"
+					}`,
+					mockDoc.Title, escapeNewlines(body), mockDoc.Category, mockDoc.Type,
+				),
+				ExpectError: regexp.MustCompile("body_format must be one of 'readme' or 'gfm'"),
+			},
+			// Test that GitHub-flavored Markdown is converted before it's sent.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					resource "readme_doc" "test" {
+						title       = "%s"
+						body        = "%s"
+						body_format = "gfm"
+						category    = "%s"
+						type        = "%s"
+					}`,
+					mockDoc.Title, escapeNewlines(body), mockDoc.Category, mockDoc.Type,
+				),
+				PreConfig: func() {
+					docCommonGocks()
+					gock.New(testURL).
+						Post("/docs").
+						MatchType("json").
+						BodyString(regexp.QuoteMeta("(doc:setup)")).
+						Times(1).
+						Reply(201).
+						JSON(convertedDoc)
+					gock.New(testURL).Get("/docs/" + mockDoc.Slug).Persist().Reply(200).JSON(convertedDoc)
+					gock.New(testURL).Delete("/docs/" + mockDoc.Slug).Times(1).Reply(204)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_doc.test", "body", body),
+					resource.TestCheckResourceAttr("readme_doc.test", "body_clean", convertedDoc.Body),
+					resource.TestCheckResourceAttr("readme_doc.test", "body_format", "gfm"),
+				),
+			},
+		},
+	})
+}
+
+func TestDocResource_LinkCheck(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	body := "See the [existing doc](doc:existing) and [missing doc](/docs/missing#setup)."
+
+	config := func(linkCheck string) string {
+		return providerConfig + fmt.Sprintf(`
+			resource "readme_doc" "test" {
+				title      = "%s"
+				body       = "%s"
+				category   = "%s"
+				type       = "%s"
+				link_check = "%s"
+			}`,
+			mockDoc.Title, body, mockDoc.Category, mockDoc.Type, linkCheck,
+		)
+	}
+
+	// mockGocks registers the mocks for listing the docs in the version.
+	// The category docs are mocked before the category lookup in docCommonGocks.
+	mockGocks := func() {
+		gock.OffAll()
+		mockCategoryDocs(mockCategory.Slug, []readme.CategoryDocs{{Slug: "existing", Title: "Existing"}})
+		docCommonGocks()
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test an invalid link check mode.
+			{
+				Config:      config("invalid"),
+				ExpectError: regexp.MustCompile("link_check must be one of 'off', 'warn', or 'error'"),
+			},
+			// Test that a broken link fails the plan when link_check is "error".
+			{
+				Config:      config("error"),
+				PreConfig:   mockGocks,
+				ExpectError: regexp.MustCompile(`/docs/missing#setup \(doc 'missing' not found\)`),
+			},
+			// Test that a broken link doesn't fail the plan when link_check is "warn".
+			{
+				Config: config("warn"),
+				PreConfig: func() {
+					doc := mockDoc
+					doc.Body = body
+
+					mockGocks()
+					gock.New(testURL).Post("/docs").Times(1).Reply(201).JSON(doc)
+					gock.New(testURL).Get("/docs/" + doc.Slug).Persist().Reply(200).JSON(doc)
+					gock.New(testURL).Delete("/docs/" + doc.Slug).Times(1).Reply(204)
+				},
+				Check: resource.TestCheckResourceAttr("readme_doc.test", "link_check", "warn"),
+			},
+		},
+	})
+}
+
+func TestDocResource_Include(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	dir := t.TempDir()
+	sourceFile := dir + "/doc.md"
+	writeFile := func(file, content string) {
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatalf("unable to write file: %s", err)
+		}
+	}
+
+	writeExample := func(call string) {
+		writeFile(dir+"/client.go", "package main\n\nfunc main() {\n\t// #region auth\n\t"+call+"\n"+
+			"\t// #endregion auth\n}\n")
+	}
+
+	body := func(region string) string {
+		return "Authenticate:\n\n```go\n{{< include \"client.go\" region=\"" + region + "\" >}}\n```"
+	}
+
+	doc := mockDoc
+	doc.Body = "Authenticate:\n\n```go\nclient := readme.NewClient(token)\n```"
+
+	config := providerConfig + fmt.Sprintf(`
+		resource "readme_doc" "test" {
+			title       = "%s"
+			source_file = "%s"
+			category    = "%s"
+			type        = "%s"
+		}`,
+		mockDoc.Title, sourceFile, mockDoc.Category, mockDoc.Type,
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that a missing region fails the plan.
+			{
+				Config: config,
+				PreConfig: func() {
+					writeExample("client := readme.NewClient(token)")
+					writeFile(sourceFile, body("missing"))
+				},
+				ExpectError: regexp.MustCompile(`unable to include client.go: region "missing" not found`),
+			},
+			// Test creating a doc with an included region.
+			{
+				Config: config,
+				PreConfig: func() {
+					writeFile(sourceFile, body("auth"))
+					docCommonGocks()
+					gock.New(testURL).
+						Post("/docs").
+						BodyString(regexp.QuoteMeta("```go\\nclient := readme.NewClient(token)\\n```")).
+						Times(1).
+						Reply(201).
+						JSON(doc)
+					gock.New(testURL).Get("/docs/" + doc.Slug).Persist().Reply(200).JSON(doc)
+					gock.New(testURL).Delete("/docs/" + doc.Slug).Times(1).Reply(204)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_doc.test", "body", body("auth")),
+					resource.TestCheckResourceAttr("readme_doc.test", "body_clean", doc.Body),
+				),
+			},
+			// Test that a change to the included file changes the doc.
+			{
+				Config:             config,
+				PreConfig:          func() { writeExample("client := readme.NewClient(apiKey)") },
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestDocResource_TemplateVars(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	doc := mockDoc
+	doc.Title = "Turtle Guide"
+	// The front matter isn't published with the body.
+	doc.Body = "Welcome to TURTLE."
+
+	config := func(vars string) string {
+		return providerConfig + fmt.Sprintf(`
+			resource "readme_doc" "test" {
+				body = <<-EOT
+				---
+				title: "{{ .product }} Guide"
+				---
+				Welcome to {{ upper .product }}.
+				EOT
+
+				category      = "%s"
+				type          = "%s"
+				template_vars = %s
+			}`,
+			mockDoc.Category, mockDoc.Type, vars,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that an undefined variable fails the plan.
+			{
+				Config:      config(`{ name = "Turtle" }`),
+				ExpectError: regexp.MustCompile(`map has no entry for key "product"`),
+			},
+			// Test that the body and front matter are rendered with the variables.
+			{
+				Config: config(`{ product = "Turtle" }`),
+				PreConfig: func() {
+					docCommonGocks()
+					gock.New(testURL).
+						Post("/docs").
+						BodyString(regexp.QuoteMeta(`{"body":"Welcome to TURTLE.\n",`)).
+						Times(1).
+						Reply(201).
+						JSON(doc)
+					gock.New(testURL).Get("/docs/" + doc.Slug).Persist().Reply(200).JSON(doc)
+					gock.New(testURL).Delete("/docs/" + doc.Slug).Times(1).Reply(204)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_doc.test", "title", doc.Title),
+					resource.TestCheckResourceAttr("readme_doc.test", "body_clean", doc.Body),
+					resource.TestCheckResourceAttr("readme_doc.test", "template_vars.product", "Turtle"),
+				),
+			},
+		},
+	})
+}
+
+func TestDocResource_FrontMatterKeys(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	doc := mockDoc
+	doc.Excerpt = "A short summary."
+	doc.Deprecated = true
+	doc.Metadata = readme.DocMetadata{Title: "Turtles", Description: "All about turtles.", Image: []any{}}
+	doc.Next = readme.DocNext{
+		Description: "Read next",
+		Pages:       []readme.DocNextPages{{Name: "Setup", Slug: "setup", Type: "doc"}},
+	}
+	body := "---\n" +
+		"excerpt: A short summary.\n" +
+		"slug: " + mockDoc.Slug + "\n" +
+		"deprecated: true\n" +
+		"metadata:\n  title: Turtles\n  description: All about turtles.\n" +
+		"next:\n  description: Read next\n  pages:\n    - name: Setup\n      slug: setup\n      type: doc\n" +
+		"---\n" +
+		"A turtle has been here."
+
+	// The front matter isn't published with the body.
+	doc.Body = "A turtle has been here."
+
+	config := providerConfig + fmt.Sprintf(`
+		resource "readme_doc" "test" {
+			title    = "%s"
+			category = "%s"
+			type     = "%s"
+			body     = <<-EOT
+%s
+EOT
+		}`,
+		mockDoc.Title, mockDoc.Category, mockDoc.Type, body,
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that front matter keys that aren't supported by the API client are sent with the doc.
+			{
+				Config: config,
+				PreConfig: func() {
+					docCommonGocks()
+					gock.New(testURL).
+						Post("/docs").
+						BodyString(regexp.QuoteMeta(`"deprecated":true,"excerpt":"A short summary.",` +
+							`"metadata":{"description":"All about turtles.","image":[],"title":"Turtles"},` +
+							`"next":{"description":"Read next","pages":[{"category":"","deprecated":false,"icon":"",` +
+							`"name":"Setup","slug":"setup","type":"doc"}]},"slug":"` + mockDoc.Slug + `"`)).
+						Times(1).
+						Reply(201).
+						JSON(doc)
+					gock.New(testURL).Get("/docs/" + doc.Slug).Persist().Reply(200).JSON(doc)
+					gock.New(testURL).Delete("/docs/" + doc.Slug).Times(1).Reply(204)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_doc.test", "excerpt", doc.Excerpt),
+					resource.TestCheckResourceAttr("readme_doc.test", "deprecated", "true"),
+					resource.TestCheckResourceAttr("readme_doc.test", "metadata.title", "Turtles"),
+					resource.TestCheckResourceAttr("readme_doc.test", "metadata.image.#", "0"),
+					resource.TestCheckResourceAttr("readme_doc.test", "next.description", "Read next"),
+					resource.TestCheckResourceAttr("readme_doc.test", "next.pages.0.slug", "setup"),
+				),
+			},
+		},
+	})
+}
+
+func TestDocResource_FrontMatterMode(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	doc := mockDoc
+	body := "---\ntitle: Front Matter Title\ncategoryslug: guides\n---\nA turtle has been here."
+
+	// The front matter isn't published with the body.
+	doc.Body = "A turtle has been here."
+
+	config := func(provider, mode string) string {
+		return fmt.Sprintf(`
+			provider "readme" {
+				api_token = "%s"
+				api_url   = "%s"
+				%s
+			}
+
+			resource "readme_doc" "test" {
+				title    = "%s"
+				category = "%s"
+				type     = "%s"
+				%s
+				body     = <<-EOT
+%s
+EOT
+			}`,
+			testToken, testURL, provider, mockDoc.Title, mockDoc.Category, mockDoc.Type, mode, body,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that an invalid mode fails the plan.
+			{
+				Config:      config("", `front_matter_mode = "loose"`),
+				ExpectError: regexp.MustCompile(`front_matter_mode must be one of 'lenient' or 'strict'`),
+			},
+			// Test that strict mode reports unsupported keys and conflicts with their line numbers.
+			{
+				Config: config("", `front_matter_mode = "strict"`),
+				ExpectError: regexp.MustCompile(`(?s)line 2: key "title" conflicts with the "title" attribute.*` +
+					`line 3: unsupported key "categoryslug" \(did you mean "categorySlug"\?\)`),
+			},
+			// Test that the provider's mode is used when the resource doesn't set one.
+			{
+				Config:      config(`front_matter_mode = "strict"`, ""),
+				ExpectError: regexp.MustCompile(`Invalid front matter.`),
+			},
+			// Test that the resource's mode takes precedence over the provider's mode.
+			{
+				Config: config(`front_matter_mode = "strict"`, `front_matter_mode = "lenient"`),
+				PreConfig: func() {
+					docCommonGocks()
+					gock.New(testURL).Post("/docs").Times(1).Reply(201).JSON(doc)
+					gock.New(testURL).Get("/docs/" + doc.Slug).Persist().Reply(200).JSON(doc)
+					gock.New(testURL).Delete("/docs/" + doc.Slug).Times(1).Reply(204)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_doc.test", "title", mockDoc.Title),
+					resource.TestCheckResourceAttr("readme_doc.test", "front_matter_mode", "lenient"),
+				),
+			},
+		},
+	})
+}
+
+func TestDocResource_FrontMatterFormats(t *testing.T) {
+	testCases := []struct {
+		name   string
+		format string
+		body   string
+	}{
+		{
+			name: "yaml",
+			body: "---\ntitle: Turtle Guide\nhidden: true\norder: 3\n---\nA turtle has been here.",
+		},
+		{
+			name: "toml",
+			body: "+++\ntitle = \"Turtle Guide\"\nhidden = true\norder = 3\n+++\nA turtle has been here.",
+		},
+		{
+			name: "json object",
+			body: "{\n\"title\": \"Turtle Guide\",\n\"hidden\": true,\n\"order\": 3\n}\n\nA turtle has been here.",
+		},
+		{
+			name:   "format override",
+			format: `front_matter_format = "toml"`,
+			body:   "---\ntitle = \"Turtle Guide\"\nhidden = true\norder = 3\n---\nA turtle has been here.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Close all gocks after completion.
+			defer gock.OffAll()
+
+			doc := mockDoc
+			doc.Title = "Turtle Guide"
+			doc.Hidden = true
+			doc.Order = 3
+			// The front matter isn't published with the body.
+			doc.Body = "A turtle has been here."
+
+			config := func(attributes string) string {
+				return providerConfig + fmt.Sprintf(`
+					resource "readme_doc" "test" {
+						category = "%s"
+						type     = "%s"
+						%s
+						%s
+						body     = <<-EOT
+%s
+EOT
+					}`,
+					mockDoc.Category, mockDoc.Type, testCase.format, attributes, testCase.body,
+				)
+			}
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					// Test that strict mode reports the line numbers of the keys.
+					{
+						Config: config(`
+							title             = "Attribute Title"
+							front_matter_mode = "strict"
+						`),
+						ExpectError: regexp.MustCompile(`line 2: key "title" conflicts with the "title" attribute`),
+					},
+					// Test that the attributes are set from the front matter.
+					{
+						Config: config(""),
+						PreConfig: func() {
+							docCommonGocks()
+							gock.New(testURL).
+								Post("/docs").
+								BodyString(regexp.QuoteMeta(`"hidden":true,"order":3,"title":"Turtle Guide"`)).
+								Times(1).
+								Reply(201).
+								JSON(doc)
+							gock.New(testURL).Get("/docs/" + doc.Slug).Persist().Reply(200).JSON(doc)
+							gock.New(testURL).Delete("/docs/" + doc.Slug).Times(1).Reply(204)
+						},
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("readme_doc.test", "title", doc.Title),
+							resource.TestCheckResourceAttr("readme_doc.test", "hidden", "true"),
+							resource.TestCheckResourceAttr("readme_doc.test", "order", "3"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestDocResource_FrontMatterPassthrough(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	body := "---\n" +
+		"author: Jane\n" +
+		"tags: [turtles, reptiles]\n" +
+		"metadata:\n  title: Turtles\n" +
+		"---\n" +
+		"A turtle has been here."
+
+	doc := mockDoc
+	doc.Metadata = readme.DocMetadata{Title: "Turtles", Image: []any{}}
+	// The front matter isn't published with the body.
+	doc.Body = "A turtle has been here."
+
+	config := func(passthrough string) string {
+		return providerConfig + fmt.Sprintf(`
+			resource "readme_doc" "test" {
+				title             = "%s"
+				category          = "%s"
+				type              = "%s"
+				front_matter_mode = "strict"
+				%s
+				body              = <<-EOT
+%s
+EOT
+			}`,
+			mockDoc.Title, mockDoc.Category, mockDoc.Type, passthrough, body,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that custom keys are unsupported if they aren't passed through.
+			{
+				Config:      config(""),
+				ExpectError: regexp.MustCompile(`line 2: unsupported key "author"`),
+			},
+			// Test that custom keys are sent in the metadata and the front matter is removed from the body.
+			{
+				Config: config("front_matter_passthrough = true"),
+				PreConfig: func() {
+					docCommonGocks()
+					gock.New(testURL).
+						Post("/docs").
+						BodyString(regexp.QuoteMeta(`{"body":"A turtle has been here.\n",`) + ".*" +
+							regexp.QuoteMeta(`"metadata":{"author":"Jane","description":"","image":[],`+
+								`"tags":["turtles","reptiles"],"title":"Turtles"}`)).
+						Times(1).
+						Reply(201).
+						JSON(doc)
+					gock.New(testURL).Get("/docs/" + doc.Slug).Persist().Reply(200).JSON(doc)
+					gock.New(testURL).Delete("/docs/" + doc.Slug).Times(1).Reply(204)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_doc.test", "body_clean", doc.Body),
+					resource.TestCheckResourceAttr("readme_doc.test", "metadata.title", "Turtles"),
+				),
+			},
+		},
+	})
+}
@@ -2,19 +2,42 @@ package readme
 
 import (
 	"context"
+	"fmt"
+	"html"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/liveoaklabs/readme-api-go-client/readme"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &docSearchDataSource{}
-	_ datasource.DataSourceWithConfigure = &docSearchDataSource{}
+	_ datasource.DataSource                   = &docSearchDataSource{}
+	_ datasource.DataSourceWithConfigure      = &docSearchDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &docSearchDataSource{}
 )
 
+const (
+	// docSearchTypeDoc is the search result type of guide docs.
+	docSearchTypeDoc = "doc"
+
+	// docSearchTypeReference is the search result type of API reference docs.
+	docSearchTypeReference = "reference"
+
+	// docSearchTypeChangelog is the search result type of changelogs.
+	docSearchTypeChangelog = "changelog"
+
+	// docSearchTypeCustomPage is the search result type of custom pages.
+	docSearchTypeCustomPage = "custom_page"
+)
+
+// docSearchHighlightRegexp matches the HTML tags that highlight the matched words in search results, such as
+// `<span class="algolia-search-highlight">`.
+var docSearchHighlightRegexp = regexp.MustCompile(`<[^>]*>`)
+
 // docSearchDataSource is the data source implementation.
 type docSearchDataSource struct {
 	client *readme.Client
@@ -22,9 +45,15 @@ type docSearchDataSource struct {
 
 // docSearchResultsModel represents the results from the API when searching for docs.
 type docSearchResultsModel struct {
-	ID      types.String      `tfsdk:"id"`
-	Query   types.String      `tfsdk:"query"`
-	Results *[]docSearchModel `tfsdk:"results"`
+	CategorySlug    types.String      `tfsdk:"category_slug"`
+	Hidden          types.Bool        `tfsdk:"hidden"`
+	ID              types.String      `tfsdk:"id"`
+	Limit           types.Int64       `tfsdk:"limit"`
+	Query           types.String      `tfsdk:"query"`
+	Results         *[]docSearchModel `tfsdk:"results"`
+	StripHighlights types.Bool        `tfsdk:"strip_highlights"`
+	Type            types.String      `tfsdk:"type"`
+	Version         types.String      `tfsdk:"version"`
 }
 
 // docSearchModel represents a doc item returned by a search query.
@@ -99,6 +128,168 @@ func docSearchMatchedWords(list []string) []types.String {
 	return result
 }
 
+// ValidateConfig is used for validating attribute values.
+func (d *docSearchDataSource) ValidateConfig(
+	ctx context.Context,
+	req datasource.ValidateConfigRequest,
+	resp *datasource.ValidateConfigResponse,
+) {
+	var data docSearchResultsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Limit.IsNull() && !data.Limit.IsUnknown() && data.Limit.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limit"),
+			"Invalid limit.",
+			fmt.Sprintf("limit must be at least 1, got %d.", data.Limit.ValueInt64()),
+		)
+	}
+
+	if data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+
+	switch data.Type.ValueString() {
+	case docSearchTypeDoc, docSearchTypeReference, docSearchTypeChangelog, docSearchTypeCustomPage:
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid search result type.",
+			fmt.Sprintf("type must be one of '%s', '%s', '%s', or '%s', got '%s'.",
+				docSearchTypeDoc, docSearchTypeReference, docSearchTypeChangelog, docSearchTypeCustomPage,
+				data.Type.ValueString()),
+		)
+	}
+}
+
+// docSearchResultType returns the type of a search result.
+//
+// Docs and API reference docs are in the "Page" search index and are told apart by `isReference`. Changelogs and
+// custom pages are in their own search indexes.
+func docSearchResultType(result readme.DocSearchResult) string {
+	switch result.IndexName {
+	case "Changelog":
+		return docSearchTypeChangelog
+	case "CustomPage":
+		return docSearchTypeCustomPage
+	}
+
+	if result.IsReference {
+		return docSearchTypeReference
+	}
+
+	return docSearchTypeDoc
+}
+
+// stripHighlights returns a highlighted search result value as plain text.
+func stripHighlights(value string) string {
+	return html.UnescapeString(docSearchHighlightRegexp.ReplaceAllString(value, ""))
+}
+
+// categoryDocSlugs returns the slugs of the docs in a category, including child docs.
+func (d *docSearchDataSource) categoryDocSlugs(slug, version string) (map[string]bool, error) {
+	docs, apiResponse, err := d.client.Category.GetDocs(slug, readme.RequestOptions{Version: version})
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve docs for category %s: %s", slug, clientError(err, apiResponse))
+	}
+
+	slugs := map[string]bool{}
+	for _, doc := range docs {
+		slugs[doc.Slug] = true
+		for _, child := range doc.Children {
+			slugs[child.Slug] = true
+		}
+	}
+
+	return slugs, nil
+}
+
+// resultHidden returns whether the page of a search result is hidden. Search results don't include the visibility
+// of their pages, so each page is retrieved.
+func (d *docSearchDataSource) resultHidden(result readme.DocSearchResult, version string) (bool, error) {
+	var hidden bool
+	var apiResponse *readme.APIResponse
+	var err error
+
+	switch docSearchResultType(result) {
+	case docSearchTypeChangelog:
+		var changelog readme.Changelog
+		changelog, apiResponse, err = d.client.Changelog.Get(result.Slug)
+		hidden = changelog.Hidden
+	case docSearchTypeCustomPage:
+		var page readme.CustomPage
+		page, apiResponse, err = d.client.CustomPage.Get(result.Slug)
+		hidden = page.Hidden
+	default:
+		var doc readme.Doc
+		doc, apiResponse, err = d.client.Doc.Get(result.Slug, readme.RequestOptions{Version: version})
+		hidden = doc.Hidden
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("unable to retrieve %s: %s", result.Slug, clientError(err, apiResponse))
+	}
+
+	return hidden, nil
+}
+
+// filterResults returns the search results that match the filters, up to the limit.
+//
+// The search API only supports scoping the search to a version, so the other filters are applied to the results.
+func (d *docSearchDataSource) filterResults(
+	results []readme.DocSearchResult,
+	state docSearchResultsModel,
+) ([]readme.DocSearchResult, error) {
+	version := state.Version.ValueString()
+
+	var categorySlugs map[string]bool
+	if !state.CategorySlug.IsNull() {
+		slugs, err := d.categoryDocSlugs(state.CategorySlug.ValueString(), version)
+		if err != nil {
+			return nil, err
+		}
+
+		categorySlugs = slugs
+	}
+
+	filtered := []readme.DocSearchResult{}
+
+	for _, result := range results {
+		if !state.Limit.IsNull() && int64(len(filtered)) >= state.Limit.ValueInt64() {
+			break
+		}
+
+		resultType := docSearchResultType(result)
+		if !state.Type.IsNull() && resultType != state.Type.ValueString() {
+			continue
+		}
+
+		// Only docs belong to categories.
+		if categorySlugs != nil &&
+			(!categorySlugs[result.Slug] || (resultType != docSearchTypeDoc && resultType != docSearchTypeReference)) {
+			continue
+		}
+
+		if !state.Hidden.IsNull() {
+			hidden, err := d.resultHidden(result, version)
+			if err != nil {
+				return nil, err
+			}
+
+			if hidden != state.Hidden.ValueBool() {
+				continue
+			}
+		}
+
+		filtered = append(filtered, result)
+	}
+
+	return filtered, nil
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *docSearchDataSource) Read(
 	ctx context.Context,
@@ -115,32 +306,51 @@ func (d *docSearchDataSource) Read(
 	}
 
 	// Get doc metadata from ReadMe API
-	doc, apiResponse, err := d.client.Doc.Search(state.Query.ValueString())
+	doc, apiResponse, err := d.client.Doc.Search(
+		state.Query.ValueString(),
+		readme.RequestOptions{Version: state.Version.ValueString()},
+	)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to retrieve doc metadata.", clientError(err, apiResponse))
 
 		return
 	}
 
+	doc, err = d.filterResults(doc, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to filter search results.", err.Error())
+
+		return
+	}
+
+	// text returns a highlighted value as plain text if strip_highlights is enabled.
+	text := func(value string) types.String {
+		if state.StripHighlights.ValueBool() {
+			return types.StringValue(stripHighlights(value))
+		}
+
+		return types.StringValue(value)
+	}
+
 	// Map response body to model
 	results := []docSearchModel{}
 	for _, result := range doc {
 		results = append(results, docSearchModel{
 			HighlightResult: docSearchModelHighlightResult{
 				Body: docSearchModelHighlightResultValue{
-					Value:        types.StringValue(result.HighlightResult.Body.Value),
+					Value:        text(result.HighlightResult.Body.Value),
 					MatchLevel:   types.StringValue(result.HighlightResult.Body.MatchLevel),
 					MatchedWords: docSearchMatchedWords(result.HighlightResult.Body.MatchedWords),
 				},
 				Excerpt: docSearchModelHighlightResultValue{
-					Value:      types.StringValue(result.HighlightResult.Excerpt.Value),
+					Value:      text(result.HighlightResult.Excerpt.Value),
 					MatchLevel: types.StringValue(result.HighlightResult.Excerpt.MatchLevel),
 					MatchedWords: docSearchMatchedWords(
 						result.HighlightResult.Excerpt.MatchedWords,
 					),
 				},
 				Title: docSearchModelHighlightResultValue{
-					Value:        types.StringValue(result.HighlightResult.Title.Value),
+					Value:        text(result.HighlightResult.Title.Value),
 					MatchLevel:   types.StringValue(result.HighlightResult.Title.MatchLevel),
 					MatchedWords: docSearchMatchedWords(result.HighlightResult.Title.MatchedWords),
 				},
@@ -156,15 +366,15 @@ func (d *docSearchDataSource) Read(
 			Slug:         types.StringValue(result.Slug),
 			SnippetResult: docSearchModelSnippetResult{
 				Body: docSearchModelSnippetResultValue{
-					Value:      types.StringValue(result.SnippetResult.Body.Value),
+					Value:      text(result.SnippetResult.Body.Value),
 					MatchLevel: types.StringValue(result.SnippetResult.Body.MatchLevel),
 				},
 				Excerpt: docSearchModelSnippetResultValue{
-					Value:      types.StringValue(result.SnippetResult.Excerpt.Value),
+					Value:      text(result.SnippetResult.Excerpt.Value),
 					MatchLevel: types.StringValue(result.SnippetResult.Excerpt.MatchLevel),
 				},
 				Title: docSearchModelSnippetResultValue{
-					Value:      types.StringValue(result.SnippetResult.Title.Value),
+					Value:      text(result.SnippetResult.Title.Value),
 					MatchLevel: types.StringValue(result.SnippetResult.Title.MatchLevel),
 				},
 			},
@@ -176,11 +386,7 @@ func (d *docSearchDataSource) Read(
 		})
 	}
 
-	query := state.Query
-	state = docSearchResultsModel{
-		Query:   query,
-		Results: &results,
-	}
+	state.Results = &results
 
 	// The ID isn't returned in the data source but is tracked internally and required for testing.
 	// See https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute
@@ -215,7 +421,9 @@ func (d *docSearchDataSource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		Description: "Retrieve docs matching a search query on ReadMe.com\n\n" +
-			"See <https://docs.readme.com/main/reference/getdoc> for more information about this API endpoint.\n\n",
+			"See <https://docs.readme.com/main/reference/getdoc> for more information about this API endpoint.\n\n" +
+			"The search API only supports scoping a search to a version. The `type`, `category_slug`, `hidden`, " +
+			"and `limit` filters are applied to the results by the provider.",
 		Attributes: map[string]schema.Attribute{
 			// The 'id' isn't returned by ReadMe - it's for Terraform use to track state.
 			// See https://developer.hashicorp.com/terraform/plugin/framework/acctests#implement-id-attribute
@@ -226,6 +434,34 @@ func (d *docSearchDataSource) Schema(
 			"query": schema.StringAttribute{
 				Required: true,
 			},
+			"version": schema.StringAttribute{
+				Description: "The version to search. Defaults to the project's stable version.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return results of this type. Must be one of `doc`, `reference`, `changelog`, or " +
+					"`custom_page`.",
+				Optional: true,
+			},
+			"category_slug": schema.StringAttribute{
+				Description: "Only return docs in the category with this slug. Changelogs and custom pages are " +
+					"not in categories, so they're not returned.",
+				Optional: true,
+			},
+			"hidden": schema.BoolAttribute{
+				Description: "Only return results for hidden pages if `true`, or visible pages if `false`. The " +
+					"page of each result is retrieved to check its visibility.",
+				Optional: true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of results to return.",
+				Optional:    true,
+			},
+			"strip_highlights": schema.BoolAttribute{
+				Description: "Return the `highlight_result` and `snippet_result` values as plain text, without " +
+					"the HTML tags that highlight the matched words.",
+				Optional: true,
+			},
 			"results": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
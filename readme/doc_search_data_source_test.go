@@ -3,9 +3,11 @@ package readme
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
 	"gopkg.in/h2non/gock.v1"
 )
 
@@ -108,3 +110,204 @@ func TestDocSearchDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestDocSearchDataSource_Filters(t *testing.T) {
+	highlight := func(value string) readme.DocSearchResultHighlight {
+		return readme.DocSearchResultHighlight{
+			Title: readme.DocSearchResultHighlightValue{
+				Value:        `<span class="algolia-search-highlight">Turtles</span> &amp; ` + value,
+				MatchLevel:   "full",
+				MatchedWords: []string{"turtles"},
+			},
+		}
+	}
+
+	results := readme.DocSearchResults{
+		Results: []readme.DocSearchResult{
+			{IndexName: "Page", Slug: "turtles", Title: "Turtles", HighlightResult: highlight("Tortoises")},
+			{IndexName: "Page", Slug: "turtle-api", Title: "Turtle API", IsReference: true},
+			{IndexName: "Changelog", Slug: "turtle-release", Title: "Turtle Release"},
+			{IndexName: "CustomPage", Slug: "turtle-page", Title: "Turtle Page"},
+			{IndexName: "Page", Slug: "hidden-turtles", Title: "Hidden Turtles"},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		config string
+		mocks  func()
+		slugs  []string
+		checks []resource.TestCheckFunc
+	}{
+		{
+			name:   "type doc",
+			config: `type = "doc"`,
+			slugs:  []string{"turtles", "hidden-turtles"},
+		},
+		{
+			name:   "type reference",
+			config: `type = "reference"`,
+			slugs:  []string{"turtle-api"},
+		},
+		{
+			name:   "type changelog",
+			config: `type = "changelog"`,
+			slugs:  []string{"turtle-release"},
+		},
+		{
+			name:   "type custom page",
+			config: `type = "custom_page"`,
+			slugs:  []string{"turtle-page"},
+		},
+		{
+			name:   "category slug",
+			config: `category_slug = "reptiles"`,
+			mocks: func() {
+				gock.New(testURL).
+					Get("/categories/reptiles/docs").
+					Persist().
+					Reply(200).
+					JSON([]readme.CategoryDocs{
+						{Slug: "turtles", Children: []readme.CategoryDocsChildren{{Slug: "turtle-api"}}},
+					})
+			},
+			slugs: []string{"turtles", "turtle-api"},
+		},
+		{
+			name:   "hidden",
+			config: `hidden = true`,
+			mocks: func() {
+				for _, slug := range []string{"turtles", "turtle-api"} {
+					gock.New(testURL).Get("/docs/" + slug).Persist().Reply(200).JSON(readme.Doc{Slug: slug})
+				}
+				gock.New(testURL).
+					Get("/docs/hidden-turtles").
+					Persist().
+					Reply(200).
+					JSON(readme.Doc{Slug: "hidden-turtles", Hidden: true})
+				gock.New(testURL).
+					Get("/changelogs/turtle-release").
+					Persist().
+					Reply(200).
+					JSON(readme.Changelog{Slug: "turtle-release"})
+				gock.New(testURL).
+					Get("/custompages/turtle-page").
+					Persist().
+					Reply(200).
+					JSON(readme.CustomPage{Slug: "turtle-page"})
+			},
+			slugs: []string{"hidden-turtles"},
+		},
+		{
+			name:   "limit",
+			config: `limit = 2`,
+			slugs:  []string{"turtles", "turtle-api"},
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr(
+					"data.readme_doc_search.test",
+					"results.0.highlight_result.title.value",
+					`<span class="algolia-search-highlight">Turtles</span> &amp; Tortoises`,
+				),
+			},
+		},
+		{
+			name:   "strip highlights",
+			config: "strip_highlights = true\nlimit = 1",
+			slugs:  []string{"turtles"},
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr(
+					"data.readme_doc_search.test",
+					"results.0.highlight_result.title.value",
+					"Turtles & Tortoises",
+				),
+			},
+		},
+		{
+			name:   "version",
+			config: `version = "1.1"`,
+			slugs:  []string{"turtles", "turtle-api", "turtle-release", "turtle-page", "hidden-turtles"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Close all gocks when completed.
+			defer gock.OffAll()
+
+			checks := []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr(
+					"data.readme_doc_search.test",
+					"results.#",
+					fmt.Sprintf("%d", len(testCase.slugs)),
+				),
+			}
+
+			for i, slug := range testCase.slugs {
+				checks = append(checks, resource.TestCheckResourceAttr(
+					"data.readme_doc_search.test",
+					fmt.Sprintf("results.%d.slug", i),
+					slug,
+				))
+			}
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						PreConfig: func() {
+							gock.OffAll()
+
+							search := gock.New(testURL).
+								Post("/docs").
+								Path("search")
+							if testCase.name == "version" {
+								search = search.MatchHeader("x-readme-version", "1.1")
+							}
+							search.Persist().Reply(200).JSON(results)
+
+							if testCase.mocks != nil {
+								testCase.mocks()
+							}
+						},
+						Config: providerConfig + fmt.Sprintf(`
+							data "readme_doc_search" "test" {
+								query = "turtles"
+								%s
+							}
+						`, testCase.config),
+						Check: resource.ComposeAggregateTestCheckFunc(append(checks, testCase.checks...)...),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestDocSearchDataSource_Errors(t *testing.T) {
+	testCases := []struct {
+		config string
+		error  string
+	}{
+		{config: `type = "page"`, error: "type must be one of 'doc', 'reference', 'changelog', or 'custom_page'"},
+		{config: `limit = 0`, error: "limit must be at least 1, got 0"},
+	}
+
+	for _, testCase := range testCases {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + fmt.Sprintf(`
+						data "readme_doc_search" "test" {
+							query = "turtles"
+							%s
+						}
+					`, testCase.config),
+					ExpectError: regexp.MustCompile(testCase.error),
+				},
+			},
+		})
+	}
+}
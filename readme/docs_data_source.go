@@ -0,0 +1,563 @@
+package readme
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &docsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &docsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &docsDataSource{}
+)
+
+// docsDefaultParallelism is the number of docs that are retrieved at the same time by default.
+const docsDefaultParallelism = 4
+
+// docTypes are the valid types of docs.
+var docTypes = []string{"basic", "error", "link"}
+
+// docsDataSource is the data source implementation.
+type docsDataSource struct {
+	client *readme.Client
+}
+
+// docsDataSourceModel maps the data source schema data.
+type docsDataSourceModel struct {
+	CategorySlug  types.String        `tfsdk:"category_slug"`
+	Docs          []docsDataSourceDoc `tfsdk:"docs"`
+	Hidden        types.Bool          `tfsdk:"hidden"`
+	Hydrate       types.Bool          `tfsdk:"hydrate"`
+	ID            types.String        `tfsdk:"id"`
+	Parallelism   types.Int64         `tfsdk:"parallelism"`
+	ParentSlug    types.String        `tfsdk:"parent_slug"`
+	TitleRegex    types.String        `tfsdk:"title_regex"`
+	Type          types.String        `tfsdk:"type"`
+	UpdatedAfter  types.String        `tfsdk:"updated_after"`
+	UpdatedBefore types.String        `tfsdk:"updated_before"`
+	Version       types.String        `tfsdk:"version"`
+}
+
+// docsDataSourceDoc is a doc in the `docs` attribute.
+type docsDataSourceDoc struct {
+	Body         types.String `tfsdk:"body"`
+	CategorySlug types.String `tfsdk:"category_slug"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	Excerpt      types.String `tfsdk:"excerpt"`
+	Hidden       types.Bool   `tfsdk:"hidden"`
+	ID           types.String `tfsdk:"id"`
+	Order        types.Int64  `tfsdk:"order"`
+	ParentSlug   types.String `tfsdk:"parent_slug"`
+	Slug         types.String `tfsdk:"slug"`
+	Title        types.String `tfsdk:"title"`
+	Type         types.String `tfsdk:"type"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// NewDocsDataSource is a helper function to simplify the provider implementation.
+func NewDocsDataSource() datasource.DataSource {
+	return &docsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *docsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_docs"
+}
+
+// needsDetails returns whether the filters need the details of each doc, which aren't included in the category doc
+// lists.
+func (m docsDataSourceModel) needsDetails() bool {
+	return m.Hydrate.ValueBool() || !m.Type.IsNull() || !m.UpdatedAfter.IsNull() || !m.UpdatedBefore.IsNull()
+}
+
+// ValidateConfig is used for validating attribute values.
+func (d *docsDataSource) ValidateConfig(
+	ctx context.Context,
+	req datasource.ValidateConfigRequest,
+	resp *datasource.ValidateConfigResponse,
+) {
+	var data docsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Parallelism.IsNull() && !data.Parallelism.IsUnknown() && data.Parallelism.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parallelism"),
+			"Invalid parallelism.",
+			fmt.Sprintf("parallelism must be at least 1, got %d.", data.Parallelism.ValueInt64()),
+		)
+	}
+
+	if !data.TitleRegex.IsNull() && !data.TitleRegex.IsUnknown() {
+		if _, err := regexp.Compile(data.TitleRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("title_regex"),
+				"Invalid title regular expression.",
+				fmt.Sprintf("The regular expression '%s' is invalid: %s.", data.TitleRegex.ValueString(), err),
+			)
+		}
+	}
+
+	if !data.Type.IsNull() && !data.Type.IsUnknown() && !slices.Contains(docTypes, data.Type.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid doc type.",
+			fmt.Sprintf("type must be one of 'basic', 'error', or 'link', got '%s'.", data.Type.ValueString()),
+		)
+	}
+
+	for name, value := range map[string]types.String{
+		"updated_after":  data.UpdatedAfter,
+		"updated_before": data.UpdatedBefore,
+	} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid timestamp.",
+				fmt.Sprintf("%s must be an RFC 3339 timestamp, got '%s'.", name, value.ValueString()),
+			)
+		}
+	}
+}
+
+// categorySlugs returns the slugs of the categories to list the docs of.
+func (d *docsDataSource) categorySlugs(state docsDataSourceModel) ([]string, error) {
+	if !state.CategorySlug.IsNull() {
+		return []string{state.CategorySlug.ValueString()}, nil
+	}
+
+	categories, apiResponse, err := d.client.Category.GetAll(
+		readme.RequestOptions{Version: state.Version.ValueString()},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve categories: %s", clientError(err, apiResponse))
+	}
+
+	slugs := []string{}
+	for _, category := range categories {
+		slugs = append(slugs, category.Slug)
+	}
+
+	return slugs, nil
+}
+
+// listDocs returns the docs in each category, with each parent doc followed by its children.
+func (d *docsDataSource) listDocs(state docsDataSourceModel) ([]docsDataSourceDoc, error) {
+	categorySlugs, err := d.categorySlugs(state)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := []docsDataSourceDoc{}
+
+	for _, categorySlug := range categorySlugs {
+		categoryDocs, apiResponse, err := d.client.Category.GetDocs(
+			categorySlug,
+			readme.RequestOptions{Version: state.Version.ValueString()},
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to retrieve docs for category %s: %s", categorySlug, clientError(err, apiResponse),
+			)
+		}
+
+		for _, parent := range categoryDocs {
+			docs = append(docs, newDocsDataSourceDoc(
+				categorySlug, types.StringNull(), parent.ID, parent.Slug, parent.Title, parent.Order, parent.Hidden,
+			))
+
+			for _, child := range parent.Children {
+				docs = append(docs, newDocsDataSourceDoc(
+					categorySlug, types.StringValue(parent.Slug), child.ID, child.Slug, child.Title, child.Order,
+					child.Hidden,
+				))
+			}
+		}
+	}
+
+	return docs, nil
+}
+
+// newDocsDataSourceDoc returns a doc from a category doc list, without its details.
+func newDocsDataSourceDoc(
+	categorySlug string,
+	parentSlug types.String,
+	id, slug, title string,
+	order int,
+	hidden bool,
+) docsDataSourceDoc {
+	return docsDataSourceDoc{
+		Body:         types.StringNull(),
+		CategorySlug: types.StringValue(categorySlug),
+		CreatedAt:    types.StringNull(),
+		Excerpt:      types.StringNull(),
+		Hidden:       types.BoolValue(hidden),
+		ID:           types.StringValue(id),
+		Order:        types.Int64Value(int64(order)),
+		ParentSlug:   parentSlug,
+		Slug:         types.StringValue(slug),
+		Title:        types.StringValue(title),
+		Type:         types.StringNull(),
+		UpdatedAt:    types.StringNull(),
+	}
+}
+
+// docsDataSourceFilters are the parsed values of the filters that aren't compared as they're configured.
+type docsDataSourceFilters struct {
+	titleRegex    *regexp.Regexp
+	updatedAfter  *time.Time
+	updatedBefore *time.Time
+}
+
+// parseFilters parses the title regular expression and the update timestamps of the configuration.
+//
+// ValidateConfig skips values that are unknown at plan time, so the values are checked again when they're known.
+func (m docsDataSourceModel) parseFilters() (docsDataSourceFilters, diag.Diagnostics) {
+	var filters docsDataSourceFilters
+
+	var diags diag.Diagnostics
+
+	if !m.TitleRegex.IsNull() {
+		titleRegex, err := regexp.Compile(m.TitleRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("title_regex"),
+				"Invalid title regular expression.",
+				fmt.Sprintf("The regular expression '%s' is invalid: %s.", m.TitleRegex.ValueString(), err),
+			)
+		}
+
+		filters.titleRegex = titleRegex
+	}
+
+	for name, value := range map[string]types.String{
+		"updated_after":  m.UpdatedAfter,
+		"updated_before": m.UpdatedBefore,
+	} {
+		if value.IsNull() {
+			continue
+		}
+
+		timestamp, err := time.Parse(time.RFC3339, value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid timestamp.",
+				fmt.Sprintf("%s must be an RFC 3339 timestamp, got '%s'.", name, value.ValueString()),
+			)
+
+			continue
+		}
+
+		if name == "updated_after" {
+			filters.updatedAfter = &timestamp
+		} else {
+			filters.updatedBefore = &timestamp
+		}
+	}
+
+	return filters, diags
+}
+
+// filterListedDocs returns the docs that match the filters that don't need the details of each doc.
+func filterListedDocs(
+	docs []docsDataSourceDoc,
+	state docsDataSourceModel,
+	filters docsDataSourceFilters,
+) []docsDataSourceDoc {
+	filtered := []docsDataSourceDoc{}
+
+	for _, doc := range docs {
+		if filters.titleRegex != nil && !filters.titleRegex.MatchString(doc.Title.ValueString()) {
+			continue
+		}
+
+		if !state.Hidden.IsNull() && doc.Hidden.ValueBool() != state.Hidden.ValueBool() {
+			continue
+		}
+
+		if !state.ParentSlug.IsNull() && doc.ParentSlug.ValueString() != state.ParentSlug.ValueString() {
+			continue
+		}
+
+		filtered = append(filtered, doc)
+	}
+
+	return filtered
+}
+
+// hydrateDocs retrieves the details of each doc, with at most `parallelism` docs retrieved at the same time.
+func (d *docsDataSource) hydrateDocs(docs []docsDataSourceDoc, state docsDataSourceModel) ([]readme.Doc, error) {
+	parallelism := docsDefaultParallelism
+	if !state.Parallelism.IsNull() {
+		parallelism = int(state.Parallelism.ValueInt64())
+	}
+
+	details := make([]readme.Doc, len(docs))
+	errs := make([]error, len(docs))
+
+	forEachParallel(len(docs), parallelism, func(index int) {
+		slug := docs[index].Slug.ValueString()
+
+		doc, apiResponse, err := d.client.Doc.Get(slug, readme.RequestOptions{Version: state.Version.ValueString()})
+		if err != nil {
+			errs[index] = fmt.Errorf("unable to retrieve doc %s: %s", slug, clientError(err, apiResponse))
+		}
+
+		details[index] = doc
+	})
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return details, nil
+}
+
+// filterHydratedDocs returns the docs that match the filters that need the details of each doc. The details are
+// added to the returned docs when `hydrate` is true.
+func filterHydratedDocs(
+	docs []docsDataSourceDoc,
+	details []readme.Doc,
+	state docsDataSourceModel,
+	filters docsDataSourceFilters,
+) ([]docsDataSourceDoc, error) {
+	filtered := []docsDataSourceDoc{}
+
+	for i, doc := range docs {
+		detail := details[i]
+
+		if !state.Type.IsNull() && detail.Type != state.Type.ValueString() {
+			continue
+		}
+
+		if filters.updatedAfter != nil || filters.updatedBefore != nil {
+			updatedAt, err := time.Parse(time.RFC3339, detail.UpdatedAt)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse the update time of doc %s: %w", detail.Slug, err)
+			}
+
+			if filters.updatedAfter != nil && !updatedAt.After(*filters.updatedAfter) {
+				continue
+			}
+
+			if filters.updatedBefore != nil && !updatedAt.Before(*filters.updatedBefore) {
+				continue
+			}
+		}
+
+		if state.Hydrate.ValueBool() {
+			doc.Body = types.StringValue(detail.Body)
+			doc.CreatedAt = types.StringValue(detail.CreatedAt)
+			doc.Excerpt = types.StringValue(detail.Excerpt)
+			doc.Type = types.StringValue(detail.Type)
+			doc.UpdatedAt = types.StringValue(detail.UpdatedAt)
+		}
+
+		filtered = append(filtered, doc)
+	}
+
+	return filtered, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *docsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state docsDataSourceModel
+
+	// Get config.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, diags := state.parseFilters()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	docs, err := d.listDocs(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve docs.", err.Error())
+
+		return
+	}
+
+	docs = filterListedDocs(docs, state, filters)
+
+	if state.needsDetails() {
+		details, err := d.hydrateDocs(docs, state)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to retrieve docs.", err.Error())
+
+			return
+		}
+
+		docs, err = filterHydratedDocs(docs, details, state, filters)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to filter docs.", err.Error())
+
+			return
+		}
+	}
+
+	state.Docs = docs
+	state.ID = types.StringValue("readme_docs")
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *docsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*readme.Client)
+}
+
+// Schema defines the schema for the data source.
+func (d *docsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the docs in every category of a version on ReadMe.com\n\n" +
+			"The docs of each category are listed with each parent doc followed by its children. The doc lists " +
+			"don't include the details of each doc, such as the body and update time. When `hydrate` is true or " +
+			"the `type`, `updated_after`, or `updated_before` filters are set, each doc is retrieved, with up to " +
+			"`parallelism` docs retrieved at the same time.\n\n" +
+			"See <https://docs.readme.com/main/reference/getcategorydocs> and " +
+			"<https://docs.readme.com/main/reference/getdoc> for more information about these API endpoints.",
+		Attributes: map[string]schema.Attribute{
+			"category_slug": schema.StringAttribute{
+				Description: "Only list the docs in the category with this slug.",
+				Optional:    true,
+			},
+			"docs": schema.ListNestedAttribute{
+				Description: "The docs that match the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"body": schema.StringAttribute{
+							Description: "The body content of the doc. Only set when `hydrate` is true.",
+							Computed:    true,
+						},
+						"category_slug": schema.StringAttribute{
+							Description: "The slug of the category the doc is in.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp of when the doc was created. Only set when `hydrate` is true.",
+							Computed:    true,
+						},
+						"excerpt": schema.StringAttribute{
+							Description: "The excerpt of the doc. Only set when `hydrate` is true.",
+							Computed:    true,
+						},
+						"hidden": schema.BoolAttribute{
+							Description: "Whether the doc is hidden.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "The ID of the doc.",
+							Computed:    true,
+						},
+						"order": schema.Int64Attribute{
+							Description: "The position of the doc in its category or under its parent doc.",
+							Computed:    true,
+						},
+						"parent_slug": schema.StringAttribute{
+							Description: "The slug of the parent doc, or null for top-level docs.",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "The slug of the doc.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the doc.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the doc. Only set when `hydrate` is true.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The timestamp of when the doc was last updated. Only set when `hydrate` is " +
+								"true.",
+							Computed: true,
+						},
+					},
+				},
+			},
+			"hidden": schema.BoolAttribute{
+				Description: "Only list hidden docs when true or visible docs when false.",
+				Optional:    true,
+			},
+			"hydrate": schema.BoolAttribute{
+				Description: "Retrieve each doc to set its `body`, `created_at`, `excerpt`, `type`, and `updated_at`. " +
+					"Defaults to false.",
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The internal Terraform ID of the data source.",
+				Computed:    true,
+			},
+			"parallelism": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of docs to retrieve at the same time. Defaults to %d.",
+					docsDefaultParallelism),
+				Optional: true,
+			},
+			"parent_slug": schema.StringAttribute{
+				Description: "Only list the child docs of the doc with this slug.",
+				Optional:    true,
+			},
+			"title_regex": schema.StringAttribute{
+				Description: "Only list the docs with a title that matches this regular expression.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only list the docs of this type. Must be one of `basic`, `error`, or `link`.",
+				Optional:    true,
+			},
+			"updated_after": schema.StringAttribute{
+				Description: "Only list the docs that were last updated after this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"updated_before": schema.StringAttribute{
+				Description: "Only list the docs that were last updated before this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The version to list the docs of. Defaults to the project's stable version.",
+				Optional:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,288 @@
+// nolint:goconst // Intentional repetition of some values for tests.
+package readme
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"gopkg.in/h2non/gock.v1"
+)
+
+// docsDataSourceGocks mocks the categories, category docs, and docs that the docs data source tests list.
+func docsDataSourceGocks() {
+	gock.New(testURL).
+		Get("/categories").
+		MatchParam("perPage", "100").
+		MatchParam("page", "1").
+		Persist().
+		Reply(200).
+		AddHeader("link", `'<>; rel="next", <>; rel="prev", <>; rel="last"'`).
+		AddHeader("x-total-count", "2").
+		JSON([]readme.Category{{Slug: "reptiles"}, {Slug: "amphibians"}})
+
+	gock.New(testURL).
+		Get("/categories/reptiles/docs").
+		Persist().
+		Reply(200).
+		JSON([]readme.CategoryDocs{
+			{
+				ID:    "1",
+				Slug:  "turtles",
+				Title: "Turtles",
+				Order: 1,
+				Children: []readme.CategoryDocsChildren{
+					{ID: "2", Slug: "sea-turtles", Title: "Sea Turtles", Order: 1, Hidden: true},
+				},
+			},
+			{ID: "3", Slug: "lizards", Title: "Lizards", Order: 2},
+		})
+
+	gock.New(testURL).
+		Get("/categories/amphibians/docs").
+		Persist().
+		Reply(200).
+		JSON([]readme.CategoryDocs{{ID: "4", Slug: "frogs", Title: "Frogs", Order: 1, Hidden: true}})
+
+	for _, doc := range []readme.Doc{
+		{Slug: "turtles", Type: "basic", Body: "Slow.", UpdatedAt: "2023-01-01T00:00:00.000Z"},
+		{Slug: "sea-turtles", Type: "link", Body: "Wet.", UpdatedAt: "2023-02-01T00:00:00.000Z"},
+		{Slug: "lizards", Type: "basic", Body: "Quick.", UpdatedAt: "2023-03-01T00:00:00.000Z"},
+		{Slug: "frogs", Type: "error", Body: "Green.", UpdatedAt: "2023-04-01T00:00:00.000Z"},
+	} {
+		gock.New(testURL).Get("/docs/" + doc.Slug).Persist().Reply(200).JSON(doc)
+	}
+}
+
+func TestDocsDataSource(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config string
+		slugs  []string
+		checks []resource.TestCheckFunc
+	}{
+		{
+			name:   "all docs",
+			config: "",
+			slugs:  []string{"turtles", "sea-turtles", "lizards", "frogs"},
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("data.readme_docs.test", "docs.0.category_slug", "reptiles"),
+				resource.TestCheckNoResourceAttr("data.readme_docs.test", "docs.0.parent_slug"),
+				resource.TestCheckResourceAttr("data.readme_docs.test", "docs.1.parent_slug", "turtles"),
+				resource.TestCheckResourceAttr("data.readme_docs.test", "docs.1.hidden", "true"),
+				resource.TestCheckResourceAttr("data.readme_docs.test", "docs.3.category_slug", "amphibians"),
+				resource.TestCheckNoResourceAttr("data.readme_docs.test", "docs.0.body"),
+				resource.TestCheckNoResourceAttr("data.readme_docs.test", "docs.0.updated_at"),
+			},
+		},
+		{
+			name:   "category slug",
+			config: `category_slug = "amphibians"`,
+			slugs:  []string{"frogs"},
+		},
+		{
+			name:   "title regex",
+			config: `title_regex = "(?i)turtles$"`,
+			slugs:  []string{"turtles", "sea-turtles"},
+		},
+		{
+			name:   "hidden",
+			config: `hidden = true`,
+			slugs:  []string{"sea-turtles", "frogs"},
+		},
+		{
+			name:   "parent slug",
+			config: `parent_slug = "turtles"`,
+			slugs:  []string{"sea-turtles"},
+		},
+		{
+			name:   "type",
+			config: `type = "basic"`,
+			slugs:  []string{"turtles", "lizards"},
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckNoResourceAttr("data.readme_docs.test", "docs.0.type"),
+			},
+		},
+		{
+			name:   "updated range",
+			config: "updated_after = \"2023-01-15T00:00:00Z\"\nupdated_before = \"2023-03-15T00:00:00Z\"",
+			slugs:  []string{"sea-turtles", "lizards"},
+		},
+		{
+			name:   "hydrate",
+			config: "hydrate = true\nparallelism = 2\nhidden = false",
+			slugs:  []string{"turtles", "lizards"},
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("data.readme_docs.test", "docs.0.body", "Slow."),
+				resource.TestCheckResourceAttr("data.readme_docs.test", "docs.0.type", "basic"),
+				resource.TestCheckResourceAttr(
+					"data.readme_docs.test",
+					"docs.1.updated_at",
+					"2023-03-01T00:00:00.000Z",
+				),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Close all gocks when completed.
+			defer gock.OffAll()
+
+			checks := []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr(
+					"data.readme_docs.test",
+					"docs.#",
+					fmt.Sprintf("%d", len(testCase.slugs)),
+				),
+			}
+
+			for i, slug := range testCase.slugs {
+				checks = append(checks, resource.TestCheckResourceAttr(
+					"data.readme_docs.test",
+					fmt.Sprintf("docs.%d.slug", i),
+					slug,
+				))
+			}
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						PreConfig: func() {
+							gock.OffAll()
+							docsDataSourceGocks()
+						},
+						Config: providerConfig + fmt.Sprintf(`
+							data "readme_docs" "test" {
+								%s
+							}
+						`, testCase.config),
+						Check: resource.ComposeAggregateTestCheckFunc(append(checks, testCase.checks...)...),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestDocsDataSource_Errors(t *testing.T) {
+	testCases := []struct {
+		config string
+		mocks  func()
+		error  string
+	}{
+		{config: `parallelism = 0`, error: "parallelism must be at least 1, got 0"},
+		{config: `title_regex = "("`, error: "The regular expression '\\(' is invalid"},
+		{config: `type = "page"`, error: "type must be one of 'basic', 'error', or 'link', got 'page'"},
+		{config: `updated_after = "yesterday"`, error: "updated_after must be an RFC 3339 timestamp"},
+		{
+			config: "",
+			mocks: func() {
+				gock.New(testURL).
+					Get("/categories").
+					Persist().
+					Reply(401).
+					JSON(map[string]string{})
+			},
+			error: "unable to retrieve categories",
+		},
+		{
+			config: `category_slug = "reptiles"`,
+			mocks: func() {
+				gock.New(testURL).
+					Get("/categories/reptiles/docs").
+					Persist().
+					Reply(404).
+					JSON(map[string]string{})
+			},
+			error: "unable to retrieve docs for category reptiles",
+		},
+		{
+			config: "category_slug = \"reptiles\"\nhydrate = true",
+			mocks: func() {
+				gock.New(testURL).
+					Get("/categories/reptiles/docs").
+					Persist().
+					Reply(200).
+					JSON([]readme.CategoryDocs{{Slug: "turtles"}})
+				gock.New(testURL).
+					Get("/docs/turtles").
+					Persist().
+					Reply(404).
+					JSON(map[string]string{})
+			},
+			error: "unable to retrieve doc turtles",
+		},
+	}
+
+	for _, testCase := range testCases {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					PreConfig: func() {
+						gock.OffAll()
+
+						if testCase.mocks != nil {
+							testCase.mocks()
+						}
+					},
+					Config: providerConfig + fmt.Sprintf(`
+						data "readme_docs" "test" {
+							%s
+						}
+					`, testCase.config),
+					ExpectError: regexp.MustCompile(testCase.error),
+				},
+			},
+		})
+
+		gock.OffAll()
+	}
+}
+
+func TestDocsDataSourceModel_ParseFilters(t *testing.T) {
+	// The values are only checked by ValidateConfig when they're known at plan time, so the filters must report
+	// values that are invalid when they're read.
+	testCases := []struct {
+		model     docsDataSourceModel
+		attribute string
+	}{
+		{model: docsDataSourceModel{TitleRegex: types.StringValue("(")}, attribute: "title_regex"},
+		{model: docsDataSourceModel{UpdatedAfter: types.StringValue("yesterday")}, attribute: "updated_after"},
+		{model: docsDataSourceModel{UpdatedBefore: types.StringValue("tomorrow")}, attribute: "updated_before"},
+	}
+
+	for _, testCase := range testCases {
+		_, diags := testCase.model.parseFilters()
+		if !diags.HasError() {
+			t.Fatalf("Expected an error for %s", testCase.attribute)
+		}
+
+		attributeDiag, ok := diags[0].(diag.DiagnosticWithPath)
+		if !ok || !attributeDiag.Path().Equal(path.Root(testCase.attribute)) {
+			t.Fatalf("Expected an attribute error for %s, got: %v", testCase.attribute, diags)
+		}
+	}
+
+	filters, diags := docsDataSourceModel{
+		TitleRegex:    types.StringValue("^Turtles"),
+		UpdatedAfter:  types.StringValue("2023-01-01T00:00:00Z"),
+		UpdatedBefore: types.StringNull(),
+	}.parseFilters()
+	if diags.HasError() {
+		t.Fatalf("Expected no errors, got: %v", diags)
+	}
+
+	if filters.titleRegex == nil || filters.updatedAfter == nil || filters.updatedBefore != nil {
+		t.Fatalf("Expected the title regex and updated_after filters only, got: %+v", filters)
+	}
+}
@@ -0,0 +1,417 @@
+package readme
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/terraform-provider-readme/readme/frontmatter"
+)
+
+// docFrontMatterParams are the parameters for creating or updating a doc, including the keys that can only be set
+// in front matter. These keys aren't supported by the API client's readme.DocParams.
+//
+// The metadata is a *frontmatter.Metadata, or a map that also includes the passed through front matter keys.
+type docFrontMatterParams struct {
+	readme.DocParams
+	Deprecated *bool             `json:"deprecated,omitempty"`
+	Excerpt    string            `json:"excerpt,omitempty"`
+	Metadata   any               `json:"metadata,omitempty"`
+	Next       *frontmatter.Next `json:"next,omitempty"`
+	Slug       string            `json:"slug,omitempty"`
+}
+
+// customPageFrontMatterParams are the parameters for creating or updating a custom page, including the keys that
+// can only be set in front matter. These keys aren't supported by the API client's readme.CustomPageParams.
+//
+// The metadata is a *frontmatter.Metadata, or a map that also includes the passed through front matter keys.
+type customPageFrontMatterParams struct {
+	readme.CustomPageParams
+	Fullscreen *bool `json:"fullscreen,omitempty"`
+	Metadata   any   `json:"metadata,omitempty"`
+}
+
+// frontMatterSettings are the attribute values that control how the body front matter is parsed, validated, and
+// sent to ReadMe.
+type frontMatterSettings struct {
+	// format is the front matter format, which is detected from the delimiters if it's empty.
+	format string
+	// mode is the front matter validation mode.
+	mode string
+	// passthrough sends the front matter keys that aren't supported by the resource in the page metadata.
+	passthrough bool
+}
+
+// newFrontMatterSettings returns the front matter settings from a resource's attributes. The provider's mode is
+// used if the resource doesn't set one.
+func newFrontMatterSettings(
+	format, mode types.String,
+	passthrough types.Bool,
+	providerMode string,
+) frontMatterSettings {
+	return frontMatterSettings{
+		format:      format.ValueString(),
+		mode:        frontMatterMode(mode, providerMode),
+		passthrough: passthrough.ValueBool(),
+	}
+}
+
+// frontMatterSchema returns the resource schema attributes for parsing and validating the body front matter.
+// This is shared by the readme_changelog, readme_custom_page, and readme_doc resources.
+func frontMatterSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"front_matter_format": schema.StringAttribute{
+			Description: "The format of the body front matter. Must be one of `yaml`, `toml`, or `json`. By default, " +
+				"the format is detected from the delimiters: `---` for YAML, `+++` for TOML, and `;;;` or an object " +
+				"at the start of the body followed by a blank line for JSON. A `---yaml`, `---toml`, or `---json` " +
+				"opening delimiter also sets the format. When set, the front matter is parsed as this format regardless of its delimiters. The " +
+				"front matter keys are the same in every format.",
+			Optional: true,
+		},
+		"front_matter_mode": schema.StringAttribute{
+			Description: "How the body front matter is validated. In `lenient` mode, attributes take precedence " +
+				"over front matter keys and unsupported keys are reported as warnings. In `strict` mode, " +
+				"unsupported keys, values of the wrong type, and keys that are also set as attributes are " +
+				"reported as errors with their line numbers. Must be one of `lenient` or `strict`. Defaults to " +
+				"the provider's `front_matter_mode`.",
+			Optional: true,
+		},
+	}
+}
+
+// validateFrontMatterMode returns an error diagnostic if the front matter mode is not a supported value.
+func validateFrontMatterMode(mode types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if mode.IsNull() || mode.IsUnknown() {
+		return diags
+	}
+
+	switch mode.ValueString() {
+	case frontmatter.ModeLenient, frontmatter.ModeStrict:
+	default:
+		diags.AddAttributeError(
+			path.Root("front_matter_mode"),
+			"Invalid front matter mode.",
+			fmt.Sprintf("front_matter_mode must be one of '%s' or '%s', got '%s'.",
+				frontmatter.ModeLenient, frontmatter.ModeStrict, mode.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// frontMatterPassthroughSchema returns the resource schema attribute for passing through front matter keys.
+// This is shared by the readme_custom_page and readme_doc resources, which have page metadata.
+func frontMatterPassthroughSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"front_matter_passthrough": schema.BoolAttribute{
+			Description: "Send the body front matter keys that aren't supported by the resource to ReadMe in the " +
+				"page `metadata` object instead of ignoring them. These keys aren't reported as unsupported by " +
+				"`front_matter_mode` when this is enabled. The front matter itself is never published with the body. " +
+				"Defaults to `false`.",
+			Optional: true,
+		},
+	}
+}
+
+// validateFrontMatterFormat returns an error diagnostic if the front matter format is not a supported value.
+func validateFrontMatterFormat(format types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if format.IsNull() || format.IsUnknown() {
+		return diags
+	}
+
+	if !slices.Contains(frontmatter.Formats, format.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("front_matter_format"),
+			"Invalid front matter format.",
+			fmt.Sprintf("front_matter_format must be one of '%s', got '%s'.",
+				strings.Join(frontmatter.Formats, "', '"), format.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// frontMatterMode returns the front matter mode from an attribute, or the default mode if it's not set.
+func frontMatterMode(mode types.String, defaultMode string) string {
+	if mode.ValueString() != "" {
+		return mode.ValueString()
+	}
+
+	if defaultMode != "" {
+		return defaultMode
+	}
+
+	return frontmatter.ModeLenient
+}
+
+// checkFrontMatter validates the front matter keys of a body.
+//
+// The `supported` parameter maps the front matter keys supported by the resource to the attributes they set and
+// `configured` lists the attributes that are set in the configuration. In strict mode, every problem is reported
+// as an error. In lenient mode, unsupported keys are reported as a warning and other problems are ignored.
+// Unsupported keys aren't reported when they're passed through.
+func checkFrontMatter(
+	body string,
+	settings frontMatterSettings,
+	sourceFile types.String,
+	supported map[string]string,
+	configured map[string]bool,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Errors parsing the front matter are reported by the front matter plan modifiers.
+	problems, err := frontmatter.Check(body, settings.format, supported, configured)
+	if err != nil || len(problems) == 0 {
+		return diags
+	}
+
+	attribute := path.Root("body")
+	if sourceFile.ValueString() != "" {
+		attribute = path.Root("source_file")
+	}
+
+	lines := []string{}
+	for _, problem := range problems {
+		if problem.Kind == frontmatter.ProblemUnsupported && settings.passthrough {
+			continue
+		}
+
+		if settings.mode == frontmatter.ModeStrict || problem.Kind == frontmatter.ProblemUnsupported {
+			lines = append(lines, "  - "+problem.String())
+		}
+	}
+
+	if len(lines) == 0 {
+		return diags
+	}
+
+	if settings.mode == frontmatter.ModeStrict {
+		diags.AddAttributeError(attribute, "Invalid front matter.",
+			"The body front matter is invalid in strict mode:\n"+strings.Join(lines, "\n"))
+
+		return diags
+	}
+
+	diags.AddAttributeWarning(attribute, "Unsupported front matter keys.",
+		"The body front matter includes keys that are not supported and are ignored:\n"+strings.Join(lines, "\n"))
+
+	return diags
+}
+
+// configuredAttributes returns the names of the attributes that are set, for checking conflicts with front matter.
+func configuredAttributes(values map[string]attr.Value) map[string]bool {
+	configured := map[string]bool{}
+	for name, value := range values {
+		configured[name] = !value.IsNull()
+	}
+
+	return configured
+}
+
+// docParamsFromFrontMatter returns the doc parameters with the keys that can only be set in the body front
+// matter and the body without its front matter. The returned bool is false if none of those keys are set.
+func docParamsFromFrontMatter(
+	params readme.DocParams,
+	settings frontMatterSettings,
+) (docFrontMatterParams, bool, error) {
+	frontMatter, err := frontmatter.Parse(params.Body, settings.format)
+	if err != nil {
+		return docFrontMatterParams{}, false, fmt.Errorf("unable to parse front matter: %w", err)
+	}
+
+	passthrough, err := passthroughValues(params.Body, settings, docFrontMatterKeys)
+	if err != nil {
+		return docFrontMatterParams{}, false, err
+	}
+
+	params.Body = frontmatter.Strip(params.Body, settings.format)
+
+	fmParams := docFrontMatterParams{
+		DocParams:  params,
+		Deprecated: frontMatter.Deprecated,
+		Excerpt:    frontMatter.Excerpt,
+		Metadata:   pageMetadata(frontMatter.Metadata, passthrough),
+		Next:       frontMatter.Next,
+		Slug:       frontMatter.Slug,
+	}
+
+	set := fmParams.Deprecated != nil || fmParams.Excerpt != "" || fmParams.Metadata != nil ||
+		fmParams.Next != nil || fmParams.Slug != ""
+
+	return fmParams, set, nil
+}
+
+// passthroughValues returns the front matter keys of a body that aren't supported by the resource with their
+// values if they're passed through. An empty map is returned if they aren't.
+func passthroughValues(
+	body string,
+	settings frontMatterSettings,
+	supported map[string]string,
+) (map[string]any, error) {
+	passthrough := map[string]any{}
+	if !settings.passthrough {
+		return passthrough, nil
+	}
+
+	values, err := frontmatter.Values(body, settings.format)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range values {
+		if _, ok := supported[key]; !ok {
+			passthrough[key] = value
+		}
+	}
+
+	return passthrough, nil
+}
+
+// pageMetadata returns the page metadata to send from the 'metadata' front matter key and the passed through
+// front matter keys, or nil if neither is set.
+func pageMetadata(metadata *frontmatter.Metadata, passthrough map[string]any) any {
+	if len(passthrough) == 0 {
+		if metadata == nil {
+			return nil
+		}
+
+		return metadata
+	}
+
+	if metadata != nil {
+		passthrough["title"] = metadata.Title
+		passthrough["description"] = metadata.Description
+		passthrough["image"] = metadata.Image
+	}
+
+	return passthrough
+}
+
+// saveDoc creates a doc if `slug` is empty or updates the doc with the slug.
+//
+// The body front matter is removed from the body that's sent. The API client is used unless the front matter sets
+// keys that the client doesn't support, in which case the request is sent with those keys included.
+func saveDoc(
+	client *readme.Client,
+	slug string,
+	params readme.DocParams,
+	settings frontMatterSettings,
+	options readme.RequestOptions,
+) (readme.Doc, *readme.APIResponse, error) {
+	fmParams, set, err := docParamsFromFrontMatter(params, settings)
+	if err != nil {
+		return readme.Doc{}, nil, err
+	}
+
+	if !set {
+		if slug == "" {
+			return client.Doc.Create(fmParams.DocParams, options)
+		}
+
+		return client.Doc.Update(slug, fmParams.DocParams, options)
+	}
+
+	request := &readme.APIRequest{
+		Method:         "POST",
+		Endpoint:       readme.DocEndpoint,
+		OkStatusCode:   []int{201},
+		RequestOptions: options,
+	}
+
+	if slug != "" {
+		request.Method = "PUT"
+		request.Endpoint = fmt.Sprintf("%s/%s", readme.DocEndpoint, slug)
+		request.OkStatusCode = []int{200}
+	}
+
+	var doc readme.Doc
+	apiResponse, err := sendFrontMatterParams(client, request, fmParams, &doc)
+
+	return doc, apiResponse, err
+}
+
+// saveCustomPage creates a custom page if `slug` is empty or updates the custom page with the slug.
+//
+// The body front matter is removed from the body that's sent. The API client is used unless the front matter sets
+// keys that the client doesn't support, in which case the request is sent with those keys included.
+func saveCustomPage(
+	client *readme.Client,
+	slug string,
+	params readme.CustomPageParams,
+	settings frontMatterSettings,
+) (readme.CustomPage, *readme.APIResponse, error) {
+	frontMatter, err := frontmatter.Parse(params.Body, settings.format)
+	if err != nil {
+		return readme.CustomPage{}, nil, fmt.Errorf("unable to parse front matter: %w", err)
+	}
+
+	passthrough, err := passthroughValues(params.Body, settings, customPageFrontMatterKeys)
+	if err != nil {
+		return readme.CustomPage{}, nil, err
+	}
+
+	metadata := pageMetadata(frontMatter.Metadata, passthrough)
+
+	params.Body = frontmatter.Strip(params.Body, settings.format)
+
+	if frontMatter.Fullscreen == nil && metadata == nil {
+		if slug == "" {
+			return client.CustomPage.Create(params)
+		}
+
+		return client.CustomPage.Update(slug, params)
+	}
+
+	request := &readme.APIRequest{
+		Method:       "POST",
+		Endpoint:     readme.CustomPageEndpoint,
+		OkStatusCode: []int{201},
+	}
+
+	if slug != "" {
+		request.Method = "PUT"
+		request.Endpoint = fmt.Sprintf("%s/%s", readme.CustomPageEndpoint, slug)
+		request.OkStatusCode = []int{200}
+	}
+
+	var page readme.CustomPage
+	apiResponse, err := sendFrontMatterParams(client, request, customPageFrontMatterParams{
+		CustomPageParams: params,
+		Fullscreen:       frontMatter.Fullscreen,
+		Metadata:         metadata,
+	}, &page)
+
+	return page, apiResponse, err
+}
+
+// sendFrontMatterParams sends a create or update request with the parameters as the JSON payload and decodes the
+// response.
+func sendFrontMatterParams(
+	client *readme.Client,
+	request *readme.APIRequest,
+	params any,
+	response any,
+) (*readme.APIResponse, error) {
+	payload, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse request: %w", err)
+	}
+
+	request.UseAuth = true
+	request.Payload = payload
+	request.Headers = []readme.RequestHeader{{"Content-Type": "application/json"}}
+	request.Response = response
+
+	return client.APIRequest(request)
+}
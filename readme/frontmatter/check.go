@@ -0,0 +1,330 @@
+package frontmatter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ModeLenient ignores front matter keys that aren't supported and lets attributes take precedence over front
+	// matter keys.
+	ModeLenient = "lenient"
+
+	// ModeStrict reports unsupported front matter keys, values of the wrong type, and keys that conflict with
+	// attributes as errors.
+	ModeStrict = "strict"
+)
+
+// ProblemKind is the kind of problem with a front matter key.
+type ProblemKind string
+
+const (
+	// ProblemUnsupported is a key that isn't supported by the resource.
+	ProblemUnsupported ProblemKind = "unsupported"
+
+	// ProblemInvalid is a key whose value can't be decoded to the key's type.
+	ProblemInvalid ProblemKind = "invalid"
+
+	// ProblemConflict is a key that sets an attribute that is also set in the configuration.
+	ProblemConflict ProblemKind = "conflict"
+)
+
+// Problem is a problem with a front matter key.
+type Problem struct {
+	// Kind is the kind of problem.
+	Kind ProblemKind
+	// Key is the front matter key.
+	Key string
+	// Line is the line number of the key in the body.
+	Line int
+	// Message describes the problem.
+	Message string
+}
+
+// String returns the problem with its line number.
+func (p Problem) String() string {
+	return fmt.Sprintf("line %d: %s", p.Line, p.Message)
+}
+
+// entry is a top-level front matter key with its line number and a function that decodes its value.
+type entry struct {
+	key    string
+	line   int
+	decode func(v any) error
+}
+
+// Check validates the front matter keys in a body.
+//
+// The `format` parameter is the front matter format, which is detected from the delimiters if it's empty. The
+// `supported` parameter maps the front matter keys supported by a resource to the names of the attributes they
+// set. The `configured` parameter lists the attributes that are set in the configuration, which conflict with the
+// front matter keys for them.
+//
+// Problems are returned for keys that aren't in `supported`, values that can't be decoded to the key's type, and
+// keys whose attribute is configured. The line numbers are relative to the start of the body.
+func Check(body, format string, supported map[string]string, configured map[string]bool) ([]Problem, error) {
+	matter, format, offset, ok := block(body, format)
+	if !ok {
+		return nil, nil
+	}
+
+	var entries []entry
+	var problem *Problem
+	var err error
+
+	switch format {
+	case FormatTOML:
+		entries, err = tomlEntries(matter)
+	case FormatJSON:
+		entries, problem, err = jsonEntries(matter)
+	default:
+		entries, problem, err = yamlEntries(matter)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse front matter: %w", err)
+	}
+
+	if problem != nil {
+		problem.Line += offset
+
+		return []Problem{*problem}, nil
+	}
+
+	fields := fieldTypes(format)
+	problems := []Problem{}
+
+	for _, item := range entries {
+		line := item.line + offset
+
+		attribute, ok := supported[item.key]
+		if !ok {
+			problems = append(problems, Problem{
+				Kind:    ProblemUnsupported,
+				Key:     item.key,
+				Line:    line,
+				Message: fmt.Sprintf("unsupported key %q%s", item.key, suggestKey(item.key, supported)),
+			})
+
+			continue
+		}
+
+		if fieldType, ok := fields[item.key]; ok {
+			if err := item.decode(reflect.New(fieldType).Interface()); err != nil {
+				problems = append(problems, Problem{
+					Kind:    ProblemInvalid,
+					Key:     item.key,
+					Line:    line,
+					Message: fmt.Sprintf("key %q has an invalid value: %s", item.key, decodeError(err)),
+				})
+
+				continue
+			}
+		}
+
+		if attribute != "" && configured[attribute] {
+			problems = append(problems, Problem{
+				Kind: ProblemConflict,
+				Key:  item.key,
+				Line: line,
+				Message: fmt.Sprintf("key %q conflicts with the %q attribute, which is also set",
+					item.key, attribute),
+			})
+		}
+	}
+
+	return problems, nil
+}
+
+// yamlEntries returns the keys of YAML front matter. A problem is returned if the front matter isn't a map.
+func yamlEntries(matter string) ([]entry, *Problem, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(matter), &root); err != nil {
+		return nil, nil, err
+	}
+
+	if len(root.Content) == 0 {
+		return nil, nil, nil
+	}
+
+	mapping := root.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return nil, &Problem{
+			Kind:    ProblemInvalid,
+			Line:    mapping.Line,
+			Message: "front matter must be a map of keys to values",
+		}, nil
+	}
+
+	entries := []entry{}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key, value := mapping.Content[i], mapping.Content[i+1]
+		entries = append(entries, entry{key: key.Value, line: key.Line, decode: value.Decode})
+	}
+
+	return entries, nil, nil
+}
+
+// jsonEntries returns the keys of JSON front matter. A problem is returned if the front matter isn't an object.
+func jsonEntries(matter string) ([]entry, *Problem, error) {
+	decoder := json.NewDecoder(strings.NewReader(matter))
+
+	token, err := decoder.Token()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, nil
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, &Problem{
+			Kind:    ProblemInvalid,
+			Line:    lineAt(matter, decoder.InputOffset()),
+			Message: "front matter must be an object of keys to values",
+		}, nil
+	}
+
+	entries := []entry{}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		key, _ := token.(string)
+		line := lineAt(matter, decoder.InputOffset())
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+
+		entries = append(entries, entry{key: key, line: line, decode: func(v any) error {
+			return json.Unmarshal(value, v)
+		}})
+	}
+
+	return entries, nil, nil
+}
+
+// tomlEntries returns the keys of TOML front matter.
+//
+// The TOML decoder doesn't report the positions of keys, so the line of each key is the first line that assigns
+// it or declares it as a table. Keys that are only set with dotted keys or table headers, such as `a.b = 1` or
+// `[a.b]`, are only listed by the decoder with their full path, so the top-level key is taken from the path.
+func tomlEntries(matter string) ([]entry, error) {
+	values := map[string]toml.Primitive{}
+
+	meta, err := toml.Decode(matter, &values)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []entry{}
+	seen := map[string]bool{}
+
+	for _, key := range meta.Keys() {
+		if seen[key[0]] {
+			continue
+		}
+
+		seen[key[0]] = true
+		value := values[key[0]]
+		entries = append(entries, entry{key: key[0], line: tomlKeyLine(matter, key[0]), decode: func(v any) error {
+			return meta.PrimitiveDecode(value, v)
+		}})
+	}
+
+	return entries, nil
+}
+
+// tomlKeyLine returns the line number of the first line that assigns a top-level TOML key or declares it as a
+// table. Zero is returned if the key isn't found.
+func tomlKeyLine(matter, key string) int {
+	quoted := regexp.QuoteMeta(key)
+	name := `(?:` + quoted + `|"` + quoted + `"|'` + quoted + `')`
+	assignment := regexp.MustCompile(`^\s*` + name + `\s*[=.]`)
+	table := regexp.MustCompile(`^\s*\[\[?\s*` + name + `\s*[\].]`)
+	inTable := false
+
+	for i, line := range strings.Split(matter, "\n") {
+		if table.MatchString(line) {
+			return i + 1
+		}
+
+		if strings.HasPrefix(strings.TrimSpace(line), "[") {
+			inTable = true
+		}
+
+		if !inTable && assignment.MatchString(line) {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// lineAt returns the line number of an offset in a string.
+func lineAt(s string, offset int64) int {
+	return strings.Count(s[:offset], "\n") + 1
+}
+
+// fieldTypes returns the types of the ReadmeFrontMatter fields keyed by their front matter keys in a format.
+func fieldTypes(format string) map[string]reflect.Type {
+	types := map[string]reflect.Type{}
+
+	t := reflect.TypeOf(ReadmeFrontMatter{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get(format), ",")
+		types[name] = t.Field(i).Type
+	}
+
+	return types
+}
+
+// suggestKey returns a suggestion for an unsupported key that only differs from a supported key by case.
+func suggestKey(key string, supported map[string]string) string {
+	names := []string{}
+	for name := range supported {
+		if strings.EqualFold(name, key) {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return ""
+	}
+
+	sort.Strings(names)
+
+	return fmt.Sprintf(" (did you mean %q?)", names[0])
+}
+
+// tomlLineRegexp matches the line number and key that prefix TOML decoding errors.
+var tomlLineRegexp = regexp.MustCompile(`^toml: line \d+ \(last key "[^"]*"\): `)
+
+// decodeError returns the message of a decoding error without the line number, which is reported separately.
+func decodeError(err error) string {
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) && len(typeErr.Errors) > 0 {
+		msg := typeErr.Errors[0]
+		if _, after, found := strings.Cut(msg, ": "); found && strings.HasPrefix(msg, "line ") {
+			return after
+		}
+
+		return msg
+	}
+
+	return tomlLineRegexp.ReplaceAllString(err.Error(), "")
+}
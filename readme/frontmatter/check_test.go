@@ -0,0 +1,183 @@
+package frontmatter
+
+import (
+	"strings"
+	"testing"
+)
+
+// checkSupported are the front matter keys supported by the Check tests mapped to the attributes they set.
+var checkSupported = map[string]string{
+	"hidden":   "hidden",
+	"metadata": "metadata",
+	"order":    "order",
+	"title":    "title",
+}
+
+// expectedProblem is a problem expected by the Check tests. The message is a substring of the problem's message.
+type expectedProblem struct {
+	kind    ProblemKind
+	key     string
+	line    int
+	message string
+}
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		name       string
+		body       string
+		format     string
+		configured map[string]bool
+		expected   []expectedProblem
+	}{
+		{
+			name:     "body without front matter",
+			body:     "# Turtles\n\nTurtles are reptiles.",
+			expected: nil,
+		},
+		{
+			name: "yaml with supported keys",
+			body: "---\ntitle: Turtles\nhidden: true\norder: 2\nmetadata:\n  title: Shells\n---\nBody",
+		},
+		{
+			name: "yaml unsupported key with a suggestion",
+			body: "---\ntitle: Turtles\nHidden: true\ncolor: green\n---\nBody",
+			expected: []expectedProblem{
+				{kind: ProblemUnsupported, key: "Hidden", line: 3, message: `unsupported key "Hidden" (did you mean "hidden"?)`},
+				{kind: ProblemUnsupported, key: "color", line: 4, message: `unsupported key "color"`},
+			},
+		},
+		{
+			name: "yaml values of the wrong type",
+			body: "---\nhidden: maybe\norder: first\nmetadata: shells\n---\nBody",
+			expected: []expectedProblem{
+				{kind: ProblemInvalid, key: "hidden", line: 2, message: `key "hidden" has an invalid value: cannot unmarshal`},
+				{kind: ProblemInvalid, key: "order", line: 3, message: `key "order" has an invalid value: cannot unmarshal`},
+				{kind: ProblemInvalid, key: "metadata", line: 4, message: `key "metadata" has an invalid value`},
+			},
+		},
+		{
+			name:       "yaml key conflicts with a configured attribute",
+			body:       "---\nhidden: true\ntitle: Turtles\n---\nBody",
+			configured: map[string]bool{"title": true},
+			expected: []expectedProblem{
+				{kind: ProblemConflict, key: "title", line: 3, message: `conflicts with the "title" attribute`},
+			},
+		},
+		{
+			name: "yaml front matter that isn't a map",
+			body: "---\n- turtles\n- tortoises\n---\nBody",
+			expected: []expectedProblem{
+				{kind: ProblemInvalid, line: 2, message: "front matter must be a map of keys to values"},
+			},
+		},
+		{
+			name: "toml with tables and dotted keys",
+			body: "+++\ntitle = \"Turtles\"\nhidden = \"yes\"\nshell.color = \"green\"\n\n" +
+				"[metadata]\ntitle = \"Shells\"\n\n[[scales]]\nsize = 1\n+++\nBody",
+			expected: []expectedProblem{
+				{kind: ProblemInvalid, key: "hidden", line: 3, message: `key "hidden" has an invalid value: incompatible`},
+				{kind: ProblemUnsupported, key: "shell", line: 4, message: `unsupported key "shell"`},
+				{kind: ProblemUnsupported, key: "scales", line: 9, message: `unsupported key "scales"`},
+			},
+		},
+		{
+			name: "toml value of the wrong type in a table",
+			body: "---toml\n[metadata]\ntitle = 1\n---\nBody",
+			expected: []expectedProblem{
+				{kind: ProblemInvalid, key: "metadata", line: 2, message: `key "metadata" has an invalid value`},
+			},
+		},
+		{
+			name: "json with delimiters",
+			body: ";;;\n{\n  \"title\": \"Turtles\",\n  \"order\": \"first\",\n  \"Title\": \"Shells\"\n}\n;;;\nBody",
+			expected: []expectedProblem{
+				{kind: ProblemInvalid, key: "order", line: 4, message: `key "order" has an invalid value`},
+				{kind: ProblemUnsupported, key: "Title", line: 5, message: `(did you mean "title"?)`},
+			},
+		},
+		{
+			name: "json object at the start of the body",
+			body: "{\n  \"hidden\": \"yes\"\n}\n\nBody",
+			expected: []expectedProblem{
+				{kind: ProblemInvalid, key: "hidden", line: 2, message: `key "hidden" has an invalid value`},
+			},
+		},
+		{
+			name: "json front matter that isn't an object",
+			body: ";;;\n[\"turtles\"]\n;;;\nBody",
+			expected: []expectedProblem{
+				{kind: ProblemInvalid, line: 2, message: "front matter must be an object of keys to values"},
+			},
+		},
+		{
+			name:   "format override",
+			body:   "---\ntitle = \"Turtles\"\ncolor = \"green\"\n---\nBody",
+			format: FormatTOML,
+			expected: []expectedProblem{
+				{kind: ProblemUnsupported, key: "color", line: 3, message: `unsupported key "color"`},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			problems, err := Check(testCase.body, testCase.format, checkSupported, testCase.configured)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(problems) != len(testCase.expected) {
+				t.Fatalf("expected %d problems, got %d: %v", len(testCase.expected), len(problems), problems)
+			}
+
+			for i, expected := range testCase.expected {
+				problem := problems[i]
+				if problem.Kind != expected.kind || problem.Key != expected.key || problem.Line != expected.line {
+					t.Errorf("expected %s problem for key %q on line %d, got %s problem for key %q on line %d",
+						expected.kind, expected.key, expected.line, problem.Kind, problem.Key, problem.Line)
+				}
+
+				if !strings.Contains(problem.Message, expected.message) {
+					t.Errorf("expected message to contain %q, got %q", expected.message, problem.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestCheck_ParseError(t *testing.T) {
+	bodies := map[string]string{
+		FormatYAML: "---\ntitle: [Turtles\n---\nBody",
+		FormatTOML: "+++\ntitle = \n+++\nBody",
+		FormatJSON: ";;;\n{\"title\": }\n;;;\nBody",
+	}
+
+	for format, body := range bodies {
+		if _, err := Check(body, "", checkSupported, nil); err == nil {
+			t.Errorf("expected an error parsing %s front matter", format)
+		}
+	}
+}
+
+func TestTOMLKeyLine(t *testing.T) {
+	matter := "title = \"Turtles\"\n\"hidden\" = true\nshell.color = \"green\"\n" +
+		"[metadata]\norder = 1\n[ next . pages ]\n[[scales]]"
+
+	cases := map[string]int{
+		"title":    1,
+		"hidden":   2,
+		"shell":    3,
+		"metadata": 4,
+		"next":     6,
+		"scales":   7,
+		// Keys that are only assigned in a table aren't top-level keys.
+		"order": 0,
+		"color": 0,
+	}
+
+	for key, expected := range cases {
+		if actual := tomlKeyLine(matter, key); actual != expected {
+			t.Errorf("tomlKeyLine(%q) = %d, expected %d", key, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package frontmatter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/adrg/frontmatter"
+	yamlv2 "gopkg.in/yaml.v2"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// FormatYAML is YAML front matter, which is delimited by `---` or starts with `---yaml`.
+	FormatYAML = "yaml"
+
+	// FormatTOML is TOML front matter, which is delimited by `+++` or starts with `---toml`.
+	FormatTOML = "toml"
+
+	// FormatJSON is JSON front matter, which is delimited by `;;;`, starts with `---json`, or is an object at the
+	// start of the body that's followed by a blank line.
+	FormatJSON = "json"
+)
+
+// Formats are the supported front matter formats.
+var Formats = []string{FormatYAML, FormatTOML, FormatJSON}
+
+// delimiter describes the delimiters of a front matter block and the format they imply.
+type delimiter struct {
+	start  string
+	end    string
+	format string
+	// inclusive is true if the delimiters are part of the front matter, such as the braces of a JSON object.
+	inclusive bool
+}
+
+// delimiters are the supported front matter delimiters, in the order they're detected.
+var delimiters = []delimiter{
+	{start: "---", end: "---", format: FormatYAML},
+	{start: "---yaml", end: "---", format: FormatYAML},
+	{start: "+++", end: "+++", format: FormatTOML},
+	{start: "---toml", end: "---", format: FormatTOML},
+	{start: ";;;", end: ";;;", format: FormatJSON},
+	{start: "---json", end: "---", format: FormatJSON},
+	{start: "{", end: "}", format: FormatJSON, inclusive: true},
+}
+
+// unmarshalers are the functions that decode each front matter format.
+var unmarshalers = map[string]frontmatter.UnmarshalFunc{
+	FormatYAML: yamlv2.Unmarshal,
+	FormatTOML: toml.Unmarshal,
+	FormatJSON: json.Unmarshal,
+}
+
+// parseFormats returns the front matter formats for parsing a body.
+//
+// If `format` is empty, the format is detected from the delimiters. Otherwise, the front matter is decoded as
+// `format` regardless of its delimiters.
+func parseFormats(format string) []*frontmatter.Format {
+	formats := []*frontmatter.Format{}
+
+	for _, delim := range delimiters {
+		decodeFormat := delim.format
+		if format != "" {
+			decodeFormat = format
+		}
+
+		// A bare object is only front matter if it's JSON.
+		if delim.inclusive && decodeFormat != FormatJSON {
+			continue
+		}
+
+		formats = append(formats, &frontmatter.Format{
+			Start:           delim.start,
+			End:             delim.end,
+			Unmarshal:       unmarshalers[decodeFormat],
+			UnmarshalDelims: delim.inclusive,
+			RequiresNewLine: delim.inclusive,
+		})
+	}
+
+	return formats
+}
+
+// locate finds the front matter at the start of a body's lines. It returns the delimiters, the format to decode
+// the front matter as, and the index of the closing delimiter line.
+//
+// If `format` is empty, the format is detected from the delimiters. The returned bool is false if the lines don't
+// start with front matter.
+func locate(lines []string, format string) (delimiter, string, int, bool) {
+	start := strings.TrimSpace(lines[0])
+
+	for _, delim := range delimiters {
+		if start != delim.start {
+			continue
+		}
+
+		decodeFormat := delim.format
+		if format != "" {
+			decodeFormat = format
+		}
+
+		if delim.inclusive && decodeFormat != FormatJSON {
+			return delimiter{}, "", 0, false
+		}
+
+		for i := 1; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) != delim.end {
+				continue
+			}
+
+			// An object must be followed by a blank line, as it's required by the parser.
+			if delim.inclusive && i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != "" {
+				continue
+			}
+
+			return delim, decodeFormat, i, true
+		}
+
+		return delimiter{}, "", 0, false
+	}
+
+	return delimiter{}, "", 0, false
+}
+
+// block returns the front matter of a body, its format, and the number of lines in the body before it.
+//
+// If `format` is empty, the format is detected from the delimiters. The returned bool is false if the body doesn't
+// start with front matter.
+func block(body, format string) (string, string, int, bool) {
+	lines := strings.Split(body, "\n")
+
+	delim, decodeFormat, end, ok := locate(lines, format)
+	if !ok {
+		return "", "", 0, false
+	}
+
+	if delim.inclusive {
+		return strings.Join(lines[:end+1], "\n"), decodeFormat, 0, true
+	}
+
+	return strings.Join(lines[1:end], "\n"), decodeFormat, 1, true
+}
+
+// Lines returns the number of lines of the front matter at the start of a body, including its delimiters, with
+// the format detected from the delimiters. Zero is returned if the body doesn't start with front matter.
+func Lines(body string) int {
+	_, _, end, ok := locate(strings.Split(body, "\n"), "")
+	if !ok {
+		return 0
+	}
+
+	return end + 1
+}
+
+// Strip returns a body without its front matter, which is only used to set attributes and isn't published.
+//
+// If `format` is empty, the format is detected from the delimiters. Blank lines between the front matter and the
+// content are removed. The body is returned unchanged if it doesn't start with front matter.
+func Strip(body, format string) string {
+	lines := strings.Split(body, "\n")
+
+	_, _, end, ok := locate(lines, format)
+	if !ok {
+		return body
+	}
+
+	return strings.TrimLeft(strings.Join(lines[end+1:], "\n"), "\r\n")
+}
+
+// Values returns the top-level keys of the front matter in a body with their values.
+//
+// If `format` is empty, the format is detected from the delimiters. An empty map is returned if the body doesn't
+// start with front matter.
+func Values(body, format string) (map[string]any, error) {
+	values := map[string]any{}
+
+	matter, format, _, ok := block(body, format)
+	if !ok {
+		return values, nil
+	}
+
+	var err error
+
+	switch format {
+	case FormatTOML:
+		_, err = toml.Decode(matter, &values)
+	case FormatJSON:
+		err = json.Unmarshal([]byte(matter), &values)
+	default:
+		err = yaml.Unmarshal([]byte(matter), &values)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse front matter: %w", err)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,107 @@
+package frontmatter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse_Formats(t *testing.T) {
+	testCases := []struct {
+		name   string
+		body   string
+		format string
+	}{
+		{name: "yaml", body: "---\ntitle: Turtles\norder: 2\n---\nBody"},
+		{name: "yaml with ---yaml", body: "---yaml\ntitle: Turtles\norder: 2\n---\nBody"},
+		{name: "toml", body: "+++\ntitle = \"Turtles\"\norder = 2\n+++\nBody"},
+		{name: "toml with ---toml", body: "---toml\ntitle = \"Turtles\"\norder = 2\n---\nBody"},
+		{name: "json with ;;;", body: ";;;\n{\"title\": \"Turtles\", \"order\": 2}\n;;;\nBody"},
+		{name: "json with ---json", body: "---json\n{\"title\": \"Turtles\", \"order\": 2}\n---\nBody"},
+		{name: "json object", body: "{\n\"title\": \"Turtles\",\n\"order\": 2\n}\n\nBody"},
+		{name: "toml override", body: "---\ntitle = \"Turtles\"\norder = 2\n---\nBody", format: FormatTOML},
+		{name: "json override", body: "+++\n{\"title\": \"Turtles\", \"order\": 2}\n+++\nBody", format: FormatJSON},
+		{name: "yaml override", body: ";;;\ntitle: Turtles\norder: 2\n;;;\nBody", format: FormatYAML},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			frontMatter, err := Parse(testCase.body, testCase.format)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if frontMatter.Title != "Turtles" || frontMatter.Order != 2 {
+				t.Errorf("expected title Turtles and order 2, got %+v", frontMatter)
+			}
+
+			values, err := Values(testCase.body, testCase.format)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if values["title"] != "Turtles" {
+				t.Errorf("expected title Turtles in values, got %v", values)
+			}
+
+			if stripped := Strip(testCase.body, testCase.format); stripped != "Body" {
+				t.Errorf("expected the front matter to be stripped, got %q", stripped)
+			}
+		})
+	}
+}
+
+func TestParse_FormatOverrideMismatch(t *testing.T) {
+	// YAML front matter decoded as TOML is an error.
+	if _, err := Parse("---\ntitle: Turtles\n---\nBody", FormatTOML); err == nil {
+		t.Error("expected an error decoding YAML front matter as TOML")
+	}
+
+	// A bare object is only front matter if it's decoded as JSON and followed by a blank line.
+	for body, format := range map[string]string{
+		"{\n\"title\": \"Turtles\"\n}\n\nBody": FormatYAML,
+		"{\n\"title\": \"Turtles\"\n}\nBody":   "",
+	} {
+		frontMatter, err := Parse(body, format)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if frontMatter.Title != "" {
+			t.Errorf("expected no front matter in %q, got %+v", body, frontMatter)
+		}
+
+		if stripped := Strip(body, format); stripped != body {
+			t.Errorf("expected the body to be unchanged, got %q", stripped)
+		}
+	}
+}
+
+func TestLines(t *testing.T) {
+	cases := map[string]int{
+		"---\ntitle: Turtles\n---\nBody":           3,
+		"+++\ntitle = \"Turtles\"\n+++\nBody":      3,
+		";;;\n{\n\"title\": \"Turtles\"\n}\n;;;\n": 5,
+		"{\n\"title\": \"Turtles\"\n}\n\nBody":     3,
+		"{\n\"title\": \"Turtles\"\n}":             3,
+		"{\n\"title\": \"Turtles\"\n}\nBody":       0,
+		"---\ntitle: Turtles\nBody":                0,
+		"# Turtles\n\n---\n":                       0,
+	}
+
+	for body, expected := range cases {
+		if actual := Lines(body); actual != expected {
+			t.Errorf("Lines(%q) = %d, expected %d", body, actual, expected)
+		}
+	}
+}
+
+func TestValues_NoFrontMatter(t *testing.T) {
+	values, err := Values("# Turtles", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(values, map[string]any{}) {
+		t.Errorf("expected no values, got %v", values)
+	}
+}
@@ -17,37 +17,105 @@ import (
 
 // ReadmeFrontMatter represents the front matter keys available to ReadMe changelogs, custom pages, and docs.
 type ReadmeFrontMatter struct {
-	Body          string                `yaml:"body,omitempty"`          // changelogs, custom pages, docs
-	Category      string                `yaml:"category"`                // docs
-	CategorySlug  string                `yaml:"categorySlug"`            // docs
-	Error         readme.DocErrorObject `yaml:"error,omitempty"`         // docs
-	Hidden        *bool                 `yaml:"hidden"`                  // changelogs, custom pages, docs
-	HTML          string                `yaml:"html,omitempty"`          // custom page
-	HTMLMode      *bool                 `yaml:"htmlmode"`                // custom page
-	Order         int64                 `yaml:"order"`                   // docs
-	ParentDoc     string                `yaml:"parentDoc,omitempty"`     // docs
-	ParentDocSlug string                `yaml:"parentDocSlug,omitempty"` // docs
-	Title         string                `yaml:"title"`                   // changelogs, custom pages, docs
-	Type          string                `yaml:"type,omitempty"`          // changelogs, docs
+	Body          string                `yaml:"body,omitempty" toml:"body" json:"body"`                            // changelogs, custom pages, docs
+	Category      string                `yaml:"category" toml:"category" json:"category"`                          // docs
+	CategorySlug  string                `yaml:"categorySlug" toml:"categorySlug" json:"categorySlug"`              // docs
+	Deprecated    *bool                 `yaml:"deprecated" toml:"deprecated" json:"deprecated"`                    // docs
+	Error         readme.DocErrorObject `yaml:"error,omitempty" toml:"error" json:"error"`                         // docs
+	Excerpt       string                `yaml:"excerpt,omitempty" toml:"excerpt" json:"excerpt"`                   // docs
+	Fullscreen    *bool                 `yaml:"fullscreen" toml:"fullscreen" json:"fullscreen"`                    // custom pages
+	Hidden        *bool                 `yaml:"hidden" toml:"hidden" json:"hidden"`                                // changelogs, custom pages, docs
+	HTML          string                `yaml:"html,omitempty" toml:"html" json:"html"`                            // custom page
+	HTMLMode      *bool                 `yaml:"htmlmode" toml:"htmlmode" json:"htmlmode"`                          // custom page
+	Metadata      *Metadata             `yaml:"metadata,omitempty" toml:"metadata" json:"metadata"`                // custom pages, docs
+	Next          *Next                 `yaml:"next,omitempty" toml:"next" json:"next"`                            // docs
+	Order         int64                 `yaml:"order" toml:"order" json:"order"`                                   // docs
+	ParentDoc     string                `yaml:"parentDoc,omitempty" toml:"parentDoc" json:"parentDoc"`             // docs
+	ParentDocSlug string                `yaml:"parentDocSlug,omitempty" toml:"parentDocSlug" json:"parentDocSlug"` // docs
+	Slug          string                `yaml:"slug,omitempty" toml:"slug" json:"slug"`                            // docs
+	Title         string                `yaml:"title" toml:"title" json:"title"`                                   // changelogs, custom pages, docs
+	Type          string                `yaml:"type,omitempty" toml:"type" json:"type"`                            // changelogs, docs
+}
+
+// Metadata represents the 'metadata' front matter key, which sets the page metadata for search engines and
+// social media previews.
+type Metadata struct {
+	Description string   `yaml:"description" toml:"description" json:"description" tfsdk:"description"`
+	Image       []string `yaml:"image" toml:"image" json:"image" tfsdk:"image"`
+	Title       string   `yaml:"title" toml:"title" json:"title" tfsdk:"title"`
+}
+
+// Next represents the 'next' front matter key, which sets the links to the next pages in a series.
+type Next struct {
+	Description string     `yaml:"description" toml:"description" json:"description" tfsdk:"description"`
+	Pages       []NextPage `yaml:"pages" toml:"pages" json:"pages" tfsdk:"pages"`
+}
+
+// NextPage represents a page in the 'next' front matter key.
+type NextPage struct {
+	Category   string `yaml:"category" toml:"category" json:"category" tfsdk:"category"`
+	Deprecated bool   `yaml:"deprecated" toml:"deprecated" json:"deprecated" tfsdk:"deprecated"`
+	Icon       string `yaml:"icon" toml:"icon" json:"icon" tfsdk:"icon"`
+	Name       string `yaml:"name" toml:"name" json:"name" tfsdk:"name"`
+	Slug       string `yaml:"slug" toml:"slug" json:"slug" tfsdk:"slug"`
+	Type       string `yaml:"type" toml:"type" json:"type" tfsdk:"type"`
+}
+
+// Parse parses the front matter of a body. Lists in the 'metadata' and 'next' keys are set to empty lists if
+// they aren't set so that they match the values returned by the API.
+//
+// The front matter may be YAML, TOML, or JSON with the same keys. If `format` is empty, the format is detected from
+// the delimiters. Otherwise, the front matter is decoded as `format` regardless of its delimiters. See Formats.
+func Parse(body, format string) (ReadmeFrontMatter, error) {
+	frontMatter := ReadmeFrontMatter{}
+	if _, err := frontmatter.Parse(strings.NewReader(body), &frontMatter, parseFormats(format)...); err != nil {
+		return frontMatter, err
+	}
+
+	if frontMatter.Metadata != nil && frontMatter.Metadata.Image == nil {
+		frontMatter.Metadata.Image = []string{}
+	}
+
+	if frontMatter.Next != nil && frontMatter.Next.Pages == nil {
+		frontMatter.Next.Pages = []NextPage{}
+	}
+
+	return frontMatter, nil
 }
 
 // GetValue parses the 'body' attribute value for Markdown front matter and
 // returns a specified key's value if it's present in the front matter.
 //
-// The `attribute` parameter is the struct field name representing the YAML
-// front matter key.
+// The `attribute` parameter is the struct field name representing the front
+// matter key. Fields of nested keys are separated by a dot, such as
+// "Metadata.Image".
+//
+// If `vars` is not nil, the body is rendered as a template with the variables
+// before the front matter is parsed. See Render.
+//
+// The `format` parameter is the front matter format, which is detected from
+// the delimiters if it's empty. See Parse.
 //
 // This returns a `reflect.Value` to be evaluated as needed based on the type
 // and other conditions.
 //
 // A string value is provided in place of an error for use with the plugin
 // framework's diagnostics package.
-func GetValue(ctx context.Context, body, attribute string) (reflect.Value, string) {
+func GetValue(
+	ctx context.Context,
+	body, attribute string,
+	vars map[string]string,
+	format string,
+) (reflect.Value, string) {
 	tflog.Debug(ctx, fmt.Sprintf("checking body front matter for attribute '%s'", attribute))
 
+	body, err := Render(body, vars)
+	if err != nil {
+		return reflect.Value{}, err.Error()
+	}
+
 	// Get the FrontMatter from the "body" attribute.
-	frontMatter := ReadmeFrontMatter{}
-	_, err := frontmatter.Parse(strings.NewReader(body), &frontMatter)
+	frontMatter, err := Parse(body, format)
 	if err != nil {
 		return reflect.Value{}, err.Error()
 	}
@@ -55,12 +123,23 @@ func GetValue(ctx context.Context, body, attribute string) (reflect.Value, strin
 	tflog.Debug(ctx, fmt.Sprintf("body front matter=%+v", frontMatter))
 
 	// Get the field value matching the attribute.
-	v := reflect.ValueOf(frontMatter)
-	field := v.FieldByName(attribute)
+	field := reflect.ValueOf(frontMatter)
+	for _, name := range strings.Split(attribute, ".") {
+		// If a parent key isn't set, return an empty value.
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				return reflect.Value{}, ""
+			}
 
-	// If the field does not exist, return an empty value.
-	if field == (reflect.Value{}) {
-		return reflect.Value{}, ""
+			field = field.Elem()
+		}
+
+		field = field.FieldByName(name)
+
+		// If the field does not exist, return an empty value.
+		if field == (reflect.Value{}) {
+			return reflect.Value{}, ""
+		}
 	}
 
 	// If the field exists and is empty, return an empty value.
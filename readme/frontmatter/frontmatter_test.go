@@ -0,0 +1,54 @@
+package frontmatter
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestGetValue(t *testing.T) {
+	body := "---\ntitle: {{ .name }}\nmetadata:\n  title: Shells\n  image:\n    - shell.png\n---\nBody"
+	vars := map[string]string{"name": "Turtles"}
+
+	testCases := []struct {
+		name      string
+		attribute string
+		expected  any
+	}{
+		{name: "field", attribute: "Title", expected: "Turtles"},
+		{name: "nested field", attribute: "Metadata.Title", expected: "Shells"},
+		{name: "nested list", attribute: "Metadata.Image", expected: []string{"shell.png"}},
+		{name: "nested field that isn't set", attribute: "Metadata.Description", expected: nil},
+		{name: "nested field of a key that isn't set", attribute: "Next.Pages", expected: nil},
+		{name: "field that isn't set", attribute: "Hidden", expected: nil},
+		{name: "field that doesn't exist", attribute: "Color", expected: nil},
+		{name: "nested field that doesn't exist", attribute: "Metadata.Color", expected: nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			value, diag := GetValue(context.Background(), body, testCase.attribute, vars, "")
+			if diag != "" {
+				t.Fatalf("unexpected error: %s", diag)
+			}
+
+			if testCase.expected == nil {
+				if value != (reflect.Value{}) {
+					t.Errorf("expected an empty value, got %v", value)
+				}
+
+				return
+			}
+
+			if value == (reflect.Value{}) || !reflect.DeepEqual(value.Interface(), testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, value)
+			}
+		})
+	}
+}
+
+func TestGetValue_Error(t *testing.T) {
+	if _, diag := GetValue(context.Background(), "---\ntitle: [Turtles\n---\nBody", "Title", nil, ""); diag == "" {
+		t.Error("expected an error parsing the front matter")
+	}
+}
@@ -3,10 +3,13 @@ package frontmatter
 import (
 	"context"
 	"fmt"
+	"os"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -18,7 +21,7 @@ type FrontMatterModifier struct {
 
 // Description returns a plain text description of the modifier's behavior.
 func (m FrontMatterModifier) Description(ctx context.Context) string {
-	return "Reads attribute values from Markdown front matter YAML."
+	return "Reads attribute values from Markdown front matter."
 }
 
 // MarkdownDescription returns a markdown formatted description of the modifier's behavior.
@@ -47,6 +50,21 @@ func GetInt64(fieldName string) planmodifier.Int64 {
 	}
 }
 
+// GetList is a plan modifier function for use on a schema list attribute to set a value from front matter.
+func GetList(fieldName string) planmodifier.List {
+	return FrontMatterModifier{
+		fieldName: fieldName,
+	}
+}
+
+// GetObject is a plan modifier function for use on a schema object attribute to set a value from front matter.
+// The front matter value is converted to the object using its `tfsdk` struct tags.
+func GetObject(fieldName string) planmodifier.Object {
+	return FrontMatterModifier{
+		fieldName: fieldName,
+	}
+}
+
 // PlanModifyString sets a string attribute's value from the body Markdown front matter if the attribute is not set and
 // a matching attribute is set in front matter.
 func (m FrontMatterModifier) PlanModifyString(
@@ -54,12 +72,12 @@ func (m FrontMatterModifier) PlanModifyString(
 	req planmodifier.StringRequest,
 	resp *planmodifier.StringResponse,
 ) {
-	var bodyPlanValue types.String
-	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("body"), &bodyPlanValue)...)
+	source, diags := planBody(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
 
 	// If the attribute isn't set, check the body front matter.
 	if req.ConfigValue.IsNull() {
-		value, diag := GetValue(ctx, bodyPlanValue.ValueString(), m.fieldName)
+		value, diag := GetValue(ctx, source.body, m.fieldName, source.vars, source.format)
 		if diag != "" {
 			resp.Diagnostics.AddError("Error parsing front matter.", diag)
 
@@ -81,12 +99,12 @@ func (m FrontMatterModifier) PlanModifyBool(
 	req planmodifier.BoolRequest,
 	resp *planmodifier.BoolResponse,
 ) {
-	var bodyPlanValue types.String
-	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("body"), &bodyPlanValue)...)
+	source, diags := planBody(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
 
 	// If the attribute isn't set, check the body front matter.
 	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
-		value, diag := GetValue(ctx, bodyPlanValue.ValueString(), m.fieldName)
+		value, diag := GetValue(ctx, source.body, m.fieldName, source.vars, source.format)
 		if diag != "" {
 			resp.Diagnostics.AddError("Error parsing front matter.", diag)
 
@@ -105,12 +123,12 @@ func (m FrontMatterModifier) PlanModifyInt64(
 	req planmodifier.Int64Request,
 	resp *planmodifier.Int64Response,
 ) {
-	var bodyPlanValue types.String
-	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("body"), &bodyPlanValue)...)
+	source, diags := planBody(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
 
 	// If the attribute isn't set, check the body front matter.
 	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
-		value, diag := GetValue(ctx, bodyPlanValue.ValueString(), m.fieldName)
+		value, diag := GetValue(ctx, source.body, m.fieldName, source.vars, source.format)
 		if diag != "" {
 			resp.Diagnostics.AddError("Error parsing front matter.", diag)
 
@@ -121,3 +139,126 @@ func (m FrontMatterModifier) PlanModifyInt64(
 		}
 	}
 }
+
+// PlanModifyList sets a list attribute's value from the body Markdown front matter if the attribute is not set and
+// a matching attribute is set in front matter.
+func (m FrontMatterModifier) PlanModifyList(
+	ctx context.Context,
+	req planmodifier.ListRequest,
+	resp *planmodifier.ListResponse,
+) {
+	source, diags := planBody(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+
+	// If the attribute isn't set, check the body front matter.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		value, diag := GetValue(ctx, source.body, m.fieldName, source.vars, source.format)
+		if diag != "" {
+			resp.Diagnostics.AddError("Error parsing front matter.", diag)
+
+			return
+		}
+		if value != (reflect.Value{}) && value.CanInterface() {
+			list, diags := types.ListValueFrom(ctx, req.PlanValue.ElementType(ctx), value.Interface())
+			resp.Diagnostics.Append(diags...)
+			resp.PlanValue = list
+		}
+	}
+}
+
+// PlanModifyObject sets an object attribute's value from the body Markdown front matter if the attribute is not set
+// and a matching attribute is set in front matter.
+func (m FrontMatterModifier) PlanModifyObject(
+	ctx context.Context,
+	req planmodifier.ObjectRequest,
+	resp *planmodifier.ObjectResponse,
+) {
+	source, diags := planBody(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+
+	// If the attribute isn't set, check the body front matter.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		value, diag := GetValue(ctx, source.body, m.fieldName, source.vars, source.format)
+		if diag != "" {
+			resp.Diagnostics.AddError("Error parsing front matter.", diag)
+
+			return
+		}
+		if value != (reflect.Value{}) && value.CanInterface() {
+			object, diags := types.ObjectValueFrom(ctx, req.PlanValue.AttributeTypes(ctx), reflect.Indirect(value).Interface())
+			resp.Diagnostics.Append(diags...)
+			resp.PlanValue = object
+		}
+	}
+}
+
+// planSource is the planned body and the attribute values for parsing its front matter.
+type planSource struct {
+	// body is the planned body or source file contents.
+	body string
+	// vars are the variables for rendering the body as a template.
+	vars map[string]string
+	// format is the front matter format, which is empty to detect it from the delimiters.
+	format string
+}
+
+// planBody returns the planned 'body' attribute value. If the body is empty and the 'source_file' attribute is set,
+// the contents of the source file are returned instead.
+//
+// The 'template_vars' attribute value is also returned for rendering the body. If the variables aren't known yet,
+// an empty body is returned since the front matter can't be rendered. The 'front_matter_format' attribute value is
+// returned for parsing the front matter.
+func planBody(ctx context.Context, plan tfsdk.Plan) (planSource, diag.Diagnostics) {
+	var body, sourceFile, format types.String
+
+	vars, known, diags := planTemplateVars(ctx, plan)
+	if !known {
+		return planSource{}, diags
+	}
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("front_matter_format"), &format)...)
+	source := planSource{vars: vars, format: format.ValueString()}
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("body"), &body)...)
+	if body.ValueString() != "" {
+		source.body = body.ValueString()
+
+		return source, diags
+	}
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("source_file"), &sourceFile)...)
+	if sourceFile.ValueString() == "" {
+		return source, diags
+	}
+
+	content, err := os.ReadFile(sourceFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_file"), "Unable to read source file.", err.Error())
+
+		return source, diags
+	}
+
+	source.body = string(content)
+
+	return source, diags
+}
+
+// planTemplateVars returns the planned 'template_vars' attribute value and whether it's known.
+// A nil map is returned if the attribute isn't set.
+func planTemplateVars(ctx context.Context, plan tfsdk.Plan) (map[string]string, bool, diag.Diagnostics) {
+	var templateVars types.Map
+
+	diags := plan.GetAttribute(ctx, path.Root("template_vars"), &templateVars)
+	if templateVars.IsUnknown() {
+		return nil, false, diags
+	}
+
+	if templateVars.IsNull() {
+		return nil, true, diags
+	}
+
+	vars := map[string]string{}
+	diags.Append(templateVars.ElementsAs(ctx, &vars, false)...)
+
+	return vars, true, diags
+}
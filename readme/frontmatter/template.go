@@ -0,0 +1,63 @@
+package frontmatter
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// shortcodeRegexp matches shortcode directives, such as `{{< include "file" >}}`, which are expanded by the
+// provider after templates are rendered.
+var shortcodeRegexp = regexp.MustCompile(`\{\{<.*?>\}\}`)
+
+// templateFuncs are the functions available to body templates in addition to the text/template builtins.
+// Only functions that transform strings are provided.
+var templateFuncs = template.FuncMap{
+	"contains":   strings.Contains,
+	"hasPrefix":  strings.HasPrefix,
+	"hasSuffix":  strings.HasSuffix,
+	"lower":      strings.ToLower,
+	"replace":    strings.ReplaceAll,
+	"trim":       strings.TrimSpace,
+	"trimPrefix": strings.TrimPrefix,
+	"trimSuffix": strings.TrimSuffix,
+	"upper":      strings.ToUpper,
+	"default": func(fallback, value string) string {
+		if value == "" {
+			return fallback
+		}
+
+		return value
+	},
+}
+
+// Render renders a body as a Go text/template with the given variables, which are referenced in the body as
+// `{{ .name }}`. A reference to an undefined variable is an error.
+//
+// Shortcode directives, such as `{{< include "file" >}}`, are left as-is.
+//
+// The body is returned unchanged if vars is nil, so bodies that aren't templates don't need to escape braces.
+func Render(body string, vars map[string]string) (string, error) {
+	if vars == nil {
+		return body, nil
+	}
+
+	// Quote shortcodes as template strings so they're output as-is.
+	escaped := shortcodeRegexp.ReplaceAllStringFunc(body, func(shortcode string) string {
+		return "{{" + strconv.Quote(shortcode) + "}}"
+	})
+
+	tmpl, err := template.New("body").Funcs(templateFuncs).Option("missingkey=error").Parse(escaped)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse template: %w", err)
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, vars); err != nil {
+		return "", fmt.Errorf("unable to render template: %w", err)
+	}
+
+	return out.String(), nil
+}
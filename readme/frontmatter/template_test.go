@@ -0,0 +1,123 @@
+package frontmatter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	vars := map[string]string{
+		"empty":   "",
+		"name":    "Turtles",
+		"padded":  "  shells  ",
+		"version": "v1.2.0",
+	}
+
+	testCases := []struct {
+		name     string
+		body     string
+		vars     map[string]string
+		expected string
+	}{
+		{
+			name:     "variable",
+			body:     "# {{ .name }}\n\nVersion {{ .version }}.",
+			vars:     vars,
+			expected: "# Turtles\n\nVersion v1.2.0.",
+		},
+		{
+			name:     "front matter",
+			body:     "---\ntitle: {{ .name }}\n---\nBody",
+			vars:     vars,
+			expected: "---\ntitle: Turtles\n---\nBody",
+		},
+		{
+			name: "string functions",
+			body: `{{ upper .name }} {{ lower .name }} {{ trim .padded }} {{ replace .name "T" "H" }} ` +
+				`{{ trimPrefix .version "v" }} {{ trimSuffix .name "s" }}`,
+			vars:     vars,
+			expected: "TURTLES turtles shells Hurtles 1.2.0 Turtle",
+		},
+		{
+			name: "conditions",
+			body: `{{ if contains .name "tle" }}a{{ end }}{{ if hasPrefix .version "v1" }}b{{ end }}` +
+				`{{ if hasSuffix .name "x" }}c{{ end }}`,
+			vars:     vars,
+			expected: "ab",
+		},
+		{
+			name:     "default",
+			body:     `{{ default "Tortoises" .empty }} {{ default "Tortoises" .name }}`,
+			vars:     vars,
+			expected: "Tortoises Turtles",
+		},
+		{
+			name:     "shortcodes in a rendered body",
+			body:     "# {{ .name }}\n\n{{< include \"shells.md\" >}}\n\n{{<include \"scales.md\">}}",
+			vars:     vars,
+			expected: "# Turtles\n\n{{< include \"shells.md\" >}}\n\n{{<include \"scales.md\">}}",
+		},
+		{
+			name:     "empty variables",
+			body:     "# Turtles",
+			vars:     map[string]string{},
+			expected: "# Turtles",
+		},
+		{
+			name:     "nil variables",
+			body:     "# {{ .name }} {{ undefined }} {{",
+			vars:     nil,
+			expected: "# {{ .name }} {{ undefined }} {{",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual, err := Render(testCase.body, testCase.vars)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if actual != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRender_Errors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "undefined variable",
+			body:     "# {{ .name }} {{ .missing }}",
+			expected: `unable to render template: template: body:1:17: executing "body" at <.missing>: map has no entry`,
+		},
+		{
+			name:     "function that isn't provided",
+			body:     `{{ env "HOME" }}`,
+			expected: `unable to parse template: template: body:1: function "env" not defined`,
+		},
+		{
+			name:     "invalid template",
+			body:     "# {{ .name ",
+			expected: "unable to parse template",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Render(testCase.body, map[string]string{"name": "Turtles"})
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if !strings.Contains(err.Error(), testCase.expected) {
+				t.Errorf("expected error to contain %q, got %q", testCase.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+// package gfm converts GitHub-flavored Markdown into ReadMe-flavored Markdown.
+// It allows docs written for GitHub to be published to ReadMe without
+// maintaining a separate copy of the content.
+//
+// The following constructs are converted:
+//
+//   - GitHub alerts, such as `> [!NOTE]`, are converted to ReadMe callouts.
+//   - Relative links to other Markdown files, such as `[Setup](./setup.md)`,
+//     are converted to ReadMe doc links, such as `[Setup](doc:setup)`.
+//   - Fenced code blocks with a `title` or `tab` attribute in the info string,
+//     such as "```js title="Node"", are converted to ReadMe code blocks with a
+//     title. Consecutive titled code blocks are joined into a ReadMe code tab
+//     block.
+//
+// Content in code blocks, inline code, and front matter is not modified.
+package gfm
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/liveoaklabs/terraform-provider-readme/readme/frontmatter"
+)
+
+// alertCallouts maps GitHub alert types to the ReadMe callout emoji and title.
+var alertCallouts = map[string]struct {
+	emoji string
+	title string
+}{
+	"NOTE":      {emoji: "📘", title: "Note"},
+	"TIP":       {emoji: "👍", title: "Tip"},
+	"IMPORTANT": {emoji: "🚧", title: "Important"},
+	"WARNING":   {emoji: "🚧", title: "Warning"},
+	"CAUTION":   {emoji: "❗️", title: "Caution"},
+}
+
+var (
+	// alertRegexp matches the first line of a GitHub alert, such as `> [!NOTE]`.
+	alertRegexp = regexp.MustCompile(`(?i)^ {0,3}>\s*\[!(NOTE|TIP|IMPORTANT|WARNING|CAUTION)\]\s*$`)
+
+	// quoteRegexp matches a blockquote line and captures its content.
+	quoteRegexp = regexp.MustCompile(`^ {0,3}> ?(.*)$`)
+
+	// fenceRegexp matches the opening line of a fenced code block and captures the indentation, fence, and info
+	// string.
+	fenceRegexp = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})(.*)$")
+
+	// titleRegexp matches the `title` or `tab` attribute of a code block info string.
+	titleRegexp = regexp.MustCompile(`\s*\b(?:title|tab)="([^"]*)"`)
+
+	// linkRegexp matches an inline link or image and captures the link text, the destination, and the optional
+	// link title.
+	linkRegexp = regexp.MustCompile(`\[([^\]]*)\]\(\s*([^)\s]+)(\s+"[^"]*")?\s*\)`)
+
+	// definitionRegexp matches a link reference definition and captures the label and destination.
+	definitionRegexp = regexp.MustCompile(`^( {0,3}\[[^\]]+\]:\s*)(\S+)(.*)$`)
+
+	// slugRegexp matches runs of characters that aren't allowed in a ReadMe slug.
+	slugRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Convert converts a GitHub-flavored Markdown body into ReadMe-flavored Markdown.
+func Convert(body string) string {
+	lines := strings.Split(body, "\n")
+	start := frontMatterEnd(lines)
+
+	out := make([]string, 0, len(lines))
+	out = append(out, lines[:start]...)
+
+	for i := start; i < len(lines); {
+		switch {
+		case fenceRegexp.MatchString(lines[i]):
+			blocks, next := codeBlocks(lines, i)
+			out = append(out, blocks...)
+			i = next
+		case alertRegexp.MatchString(lines[i]):
+			callout, next := alert(lines, i)
+			out = append(out, callout...)
+			i = next
+		default:
+			out = append(out, convertLinks(lines[i]))
+			i++
+		}
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// DocSlug returns the ReadMe doc slug for a path to a Markdown file.
+// The slug is the lowercase file name without its extension, with any
+// characters that aren't letters or numbers replaced with hyphens.
+func DocSlug(file string) string {
+	name := path.Base(file)
+	name = strings.TrimSuffix(name, path.Ext(name))
+
+	return strings.Trim(slugRegexp.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// frontMatterEnd returns the index of the first line after the front matter.
+// Zero is returned if the body doesn't start with front matter.
+func frontMatterEnd(lines []string) int {
+	return frontmatter.Lines(strings.Join(lines, "\n"))
+}
+
+// codeBlock reads a fenced code block starting at the given line. It returns the lines of the block, including
+// the fences, and the index of the line after the block. An unclosed block continues to the end of the body.
+func codeBlock(lines []string, start int) ([]string, int) {
+	match := fenceRegexp.FindStringSubmatch(lines[start])
+	fence := match[2]
+
+	for i := start + 1; i < len(lines); i++ {
+		closing := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(closing, fence) && strings.Trim(closing, fence[:1]) == "" {
+			return lines[start : i+1], i + 1
+		}
+	}
+
+	return lines[start:], len(lines)
+}
+
+// codeTitle returns the title of a code block from the `title` or `tab` attribute of its opening fence.
+func codeTitle(line string) (string, bool) {
+	match := titleRegexp.FindStringSubmatch(line)
+	if match == nil {
+		return "", false
+	}
+
+	return match[1], true
+}
+
+// titledFence returns the opening fence line of a code block with the title in ReadMe's format, which is the
+// first word of the info string after the language.
+func titledFence(line string) string {
+	title, ok := codeTitle(line)
+	if !ok {
+		return line
+	}
+
+	match := fenceRegexp.FindStringSubmatch(titleRegexp.ReplaceAllString(line, ""))
+	lang := strings.TrimSpace(match[3])
+
+	return match[1] + match[2] + strings.TrimSpace(lang+" "+title)
+}
+
+// codeBlocks reads a fenced code block starting at the given line, along with any consecutive titled code blocks
+// that form a code tab block. It returns the converted lines and the index of the line after the blocks.
+//
+// Titled code blocks that are separated only by blank lines are joined into a single ReadMe code tab block.
+func codeBlocks(lines []string, start int) ([]string, int) {
+	block, next := codeBlock(lines, start)
+
+	if _, ok := codeTitle(lines[start]); !ok {
+		return block, next
+	}
+
+	out := append([]string{titledFence(block[0])}, block[1:]...)
+
+	for {
+		i := next
+		for i < len(lines) && strings.TrimSpace(lines[i]) == "" {
+			i++
+		}
+
+		if i >= len(lines) || !fenceRegexp.MatchString(lines[i]) {
+			return out, next
+		}
+
+		if _, ok := codeTitle(lines[i]); !ok {
+			return out, next
+		}
+
+		block, next = codeBlock(lines, i)
+		out = append(out, titledFence(block[0]))
+		out = append(out, block[1:]...)
+	}
+}
+
+// alert converts a GitHub alert starting at the given line to a ReadMe callout. It returns the callout lines and
+// the index of the line after the alert.
+func alert(lines []string, start int) ([]string, int) {
+	kind := strings.ToUpper(alertRegexp.FindStringSubmatch(lines[start])[1])
+	callout := alertCallouts[kind]
+
+	out := []string{"> " + callout.emoji + " " + callout.title}
+
+	i := start + 1
+	for ; i < len(lines); i++ {
+		match := quoteRegexp.FindStringSubmatch(lines[i])
+		if match == nil {
+			break
+		}
+
+		if len(out) == 1 {
+			out = append(out, ">")
+		}
+
+		out = append(out, strings.TrimRight("> "+convertLinks(match[1]), " "))
+	}
+
+	return out, i
+}
+
+// convertLinks converts relative links to Markdown files in a line to ReadMe doc links.
+// Content in inline code spans is not modified.
+func convertLinks(line string) string {
+	if match := definitionRegexp.FindStringSubmatch(line); match != nil {
+		return match[1] + docLink(match[2]) + match[3]
+	}
+
+	// Split the line on backticks. Segments with an odd index are in inline code.
+	segments := strings.Split(line, "`")
+	for i := 0; i < len(segments); i += 2 {
+		segments[i] = convertSegmentLinks(segments[i])
+	}
+
+	return strings.Join(segments, "`")
+}
+
+// convertSegmentLinks converts the relative links to Markdown files in a segment of a line.
+// Images and escaped brackets are not modified.
+func convertSegmentLinks(segment string) string {
+	var out strings.Builder
+
+	last := 0
+	for _, match := range linkRegexp.FindAllStringSubmatchIndex(segment, -1) {
+		if match[0] > 0 && (segment[match[0]-1] == '!' || segment[match[0]-1] == '\\') {
+			continue
+		}
+
+		out.WriteString(segment[last:match[0]])
+		out.WriteString("[" + segment[match[2]:match[3]] + "](" + docLink(segment[match[4]:match[5]]))
+		if match[6] >= 0 {
+			out.WriteString(segment[match[6]:match[7]])
+		}
+		out.WriteString(")")
+
+		last = match[1]
+	}
+
+	out.WriteString(segment[last:])
+
+	return out.String()
+}
+
+// docLink returns the ReadMe doc link for a link destination if it's a relative link to a Markdown file.
+// Otherwise, the destination is returned unchanged.
+func docLink(destination string) string {
+	if strings.Contains(destination, ":") || strings.HasPrefix(destination, "/") {
+		return destination
+	}
+
+	file, fragment, _ := strings.Cut(destination, "#")
+	lower := strings.ToLower(file)
+
+	if !strings.HasSuffix(lower, ".md") && !strings.HasSuffix(lower, ".mdx") && !strings.HasSuffix(lower, ".markdown") {
+		return destination
+	}
+
+	link := "doc:" + DocSlug(file)
+	if fragment != "" {
+		link += "#" + fragment
+	}
+
+	return link
+}
@@ -0,0 +1,67 @@
+package gfm
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// update regenerates the golden files when set, for example with `go test ./readme/gfm -update`.
+var update = flag.Bool("update", false, "update golden files")
+
+// TestConvert converts each Markdown file in the testdata directory and compares the result with its golden file.
+func TestConvert(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.md")
+	if err != nil {
+		t.Fatalf("unable to list test files: %s", err)
+	}
+
+	if len(files) == 0 {
+		t.Fatal("no test files found")
+	}
+
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			input, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("unable to read test file: %s", err)
+			}
+
+			actual := Convert(string(input))
+			golden := strings.TrimSuffix(file, ".md") + ".golden"
+
+			if *update {
+				if err := os.WriteFile(golden, []byte(actual), 0o600); err != nil {
+					t.Fatalf("unable to update golden file: %s", err)
+				}
+			}
+
+			expected, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("unable to read golden file: %s", err)
+			}
+
+			if actual != string(expected) {
+				t.Errorf("converted output does not match %s\n\nexpected:\n%s\n\nactual:\n%s", golden, expected, actual)
+			}
+		})
+	}
+}
+
+func TestDocSlug(t *testing.T) {
+	cases := map[string]string{
+		"setup.md":                    "setup",
+		"./guides/Getting_Started.md": "getting-started",
+		"../API Reference.mdx":        "api-reference",
+		"v1.2-release-notes.markdown": "v1-2-release-notes",
+	}
+
+	for path, expected := range cases {
+		if actual := DocSlug(path); actual != expected {
+			t.Errorf("DocSlug(%q) = %q, expected %q", path, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,417 @@
+package readme
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// imageFormatPNG is the format name of PNG images.
+	imageFormatPNG = "png"
+
+	// imageFormatJPEG is the format name of JPEG images.
+	imageFormatJPEG = "jpeg"
+
+	// imageFormatGIF is the format name of GIF images.
+	imageFormatGIF = "gif"
+
+	// imageFormatWebP is the format name of WebP images, which can't be uploaded to ReadMe.
+	imageFormatWebP = "webp"
+)
+
+var (
+	// errMalformedImage is returned when the metadata of an image can't be removed because the image is malformed.
+	errMalformedImage = errors.New("malformed image")
+
+	// pngSignature is the signature at the start of every PNG image.
+	pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+	// pngMetadataChunks are the PNG chunks that hold metadata rather than image data.
+	pngMetadataChunks = map[string]bool{"eXIf": true, "iTXt": true, "tEXt": true, "tIME": true, "zTXt": true}
+
+	// jpegMetadataMarkers are the JPEG segment markers that hold metadata rather than image data: APP1 (EXIF and
+	// XMP), APP13 (IPTC), and comments.
+	jpegMetadataMarkers = map[byte]bool{0xE1: true, 0xED: true, 0xFE: true}
+)
+
+// imageProcessing holds the options for processing an image before it's uploaded.
+type imageProcessing struct {
+	// MaxWidth is the maximum pixel width of the image, or zero for no maximum.
+	MaxWidth int
+	// MaxHeight is the maximum pixel height of the image, or zero for no maximum.
+	MaxHeight int
+	// Format is the format to convert the image to, or empty to keep the format of the source image.
+	Format string
+	// Quality is the JPEG quality from 1 to 100, or zero for the default quality.
+	Quality int
+	// StripMetadata removes the metadata from an image that isn't otherwise re-encoded.
+	StripMetadata bool
+}
+
+// imageProcessingSchema returns the resource schema attributes for processing an image before it's uploaded.
+func imageProcessingSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"format": schema.StringAttribute{
+			Description: "The format to convert the image to before it's uploaded. Must be one of `png` or `jpeg`. " +
+				"Defaults to the format of the source image.",
+			Optional: true,
+		},
+		"max_height": schema.Int64Attribute{
+			Description: "The maximum pixel height of the uploaded image. Taller images are scaled down to fit, " +
+				"preserving the aspect ratio.",
+			Optional: true,
+		},
+		"max_width": schema.Int64Attribute{
+			Description: "The maximum pixel width of the uploaded image. Wider images are scaled down to fit, " +
+				"preserving the aspect ratio.",
+			Optional: true,
+		},
+		"quality": schema.Int64Attribute{
+			Description: "The quality of JPEG images from 1 to 100. Setting this re-encodes JPEG images at the " +
+				"given quality. Defaults to 75 when an image is converted to JPEG. This has no effect on other " +
+				"formats.",
+			Optional: true,
+		},
+		"strip_metadata": schema.BoolAttribute{
+			Description: "Remove metadata, such as EXIF data with GPS locations, XMP data, and text comments, " +
+				"from the image before it's uploaded. The image data of PNG and JPEG images is not re-encoded. " +
+				"Metadata is always removed when an image is resized, converted, or re-encoded. Defaults to `false`.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+	}
+}
+
+// validateImageProcessing returns error diagnostics for image processing options that are out of range.
+func validateImageProcessing(maxWidth, maxHeight types.Int64, format types.String, quality types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, size := range map[string]types.Int64{"max_width": maxWidth, "max_height": maxHeight} {
+		if !size.IsNull() && !size.IsUnknown() && size.ValueInt64() < 1 {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid image size.",
+				fmt.Sprintf("%s must be at least 1, got %d.", name, size.ValueInt64()),
+			)
+		}
+	}
+
+	if !quality.IsNull() && !quality.IsUnknown() && (quality.ValueInt64() < 1 || quality.ValueInt64() > 100) {
+		diags.AddAttributeError(
+			path.Root("quality"),
+			"Invalid image quality.",
+			fmt.Sprintf("quality must be between 1 and 100, got %d.", quality.ValueInt64()),
+		)
+	}
+
+	if format.IsNull() || format.IsUnknown() {
+		return diags
+	}
+
+	switch format.ValueString() {
+	case imageFormatPNG, imageFormatJPEG:
+	case imageFormatWebP:
+		diags.AddAttributeError(
+			path.Root("format"),
+			"Invalid image format.",
+			"WebP images can't be uploaded because ReadMe only accepts PNG, JPEG, and GIF images. "+
+				"Set format to 'png' or 'jpeg'.",
+		)
+	default:
+		diags.AddAttributeError(
+			path.Root("format"),
+			"Invalid image format.",
+			fmt.Sprintf("format must be one of '%s' or '%s', got '%s'.",
+				imageFormatPNG, imageFormatJPEG, format.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// newImageProcessing returns the image processing options from their attribute values. The returned bool is false
+// if any of the values are unknown.
+func newImageProcessing(
+	maxWidth, maxHeight types.Int64,
+	format types.String,
+	quality types.Int64,
+	stripMetadata types.Bool,
+) (imageProcessing, bool) {
+	if maxWidth.IsUnknown() || maxHeight.IsUnknown() || format.IsUnknown() || quality.IsUnknown() ||
+		stripMetadata.IsUnknown() {
+		return imageProcessing{}, false
+	}
+
+	return imageProcessing{
+		MaxWidth:      int(maxWidth.ValueInt64()),
+		MaxHeight:     int(maxHeight.ValueInt64()),
+		Format:        format.ValueString(),
+		Quality:       int(quality.ValueInt64()),
+		StripMetadata: stripMetadata.ValueBool(),
+	}, true
+}
+
+// processImage processes an image with the given options and returns the image to upload and its filename.
+//
+// The image is re-encoded if it's resized, converted to another format, or a JPEG quality is set. Otherwise, its
+// metadata is removed without re-encoding it if StripMetadata is set, and it's returned unchanged if not. The
+// filename's extension is changed to match the format of the image.
+func processImage(data []byte, filename string, opts imageProcessing) ([]byte, string, error) {
+	config, sourceFormat, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to decode image: %w", err)
+	}
+
+	format := opts.Format
+	if format == "" {
+		format = sourceFormat
+	}
+
+	width, height := fitImage(config.Width, config.Height, opts.MaxWidth, opts.MaxHeight)
+	resize := width != config.Width || height != config.Height
+
+	if !resize && format == sourceFormat && (format != imageFormatJPEG || opts.Quality == 0) {
+		if !opts.StripMetadata {
+			return data, filename, nil
+		}
+
+		stripped, err := stripImageMetadata(data, sourceFormat)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return stripped, filename, nil
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to decode image: %w", err)
+	}
+
+	if resize {
+		img = downscaleImage(img, width, height)
+	}
+
+	encoded := &bytes.Buffer{}
+
+	switch format {
+	case imageFormatJPEG:
+		quality := opts.Quality
+		if quality == 0 {
+			quality = jpeg.DefaultQuality
+		}
+
+		err = jpeg.Encode(encoded, flattenImage(img), &jpeg.Options{Quality: quality})
+	case imageFormatGIF:
+		err = gif.Encode(encoded, img, nil)
+	default:
+		err = (&png.Encoder{CompressionLevel: png.BestCompression}).Encode(encoded, img)
+	}
+
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to encode image as %s: %w", format, err)
+	}
+
+	if format != sourceFormat {
+		filename = strings.TrimSuffix(filename, filepath.Ext(filename)) + "." + format
+	}
+
+	return encoded.Bytes(), filename, nil
+}
+
+// fitImage returns the size of an image scaled down to fit within a maximum width and height, preserving its
+// aspect ratio. A maximum of zero is ignored.
+func fitImage(width, height, maxWidth, maxHeight int) (int, int) {
+	scale := 1.0
+	if maxWidth > 0 && width > maxWidth {
+		scale = math.Min(scale, float64(maxWidth)/float64(width))
+	}
+
+	if maxHeight > 0 && height > maxHeight {
+		scale = math.Min(scale, float64(maxHeight)/float64(height))
+	}
+
+	if scale == 1 {
+		return width, height
+	}
+
+	return max(1, int(math.Round(float64(width)*scale))), max(1, int(math.Round(float64(height)*scale)))
+}
+
+// downscaleImage scales an image down to a smaller size by averaging the source pixels that each pixel covers.
+func downscaleImage(src image.Image, width, height int) image.Image {
+	bounds := src.Bounds()
+	dst := image.NewRGBA64(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		top := bounds.Min.Y + y*bounds.Dy()/height
+		bottom := bounds.Min.Y + (y+1)*bounds.Dy()/height
+
+		for x := 0; x < width; x++ {
+			left := bounds.Min.X + x*bounds.Dx()/width
+			right := bounds.Min.X + (x+1)*bounds.Dx()/width
+
+			var red, green, blue, alpha, count uint64
+
+			for sy := top; sy < bottom; sy++ {
+				for sx := left; sx < right; sx++ {
+					r, g, b, a := src.At(sx, sy).RGBA()
+					red, green, blue, alpha = red+uint64(r), green+uint64(g), blue+uint64(b), alpha+uint64(a)
+					count++
+				}
+			}
+
+			dst.SetRGBA64(x, y, color.RGBA64{
+				R: uint16(red / count),
+				G: uint16(green / count),
+				B: uint16(blue / count),
+				A: uint16(alpha / count),
+			})
+		}
+	}
+
+	return dst
+}
+
+// flattenImage draws an image with transparency over a white background, since JPEG images can't be transparent.
+func flattenImage(img image.Image) image.Image {
+	if opaque, ok := img.(interface{ Opaque() bool }); ok && opaque.Opaque() {
+		return img
+	}
+
+	bounds := img.Bounds()
+	dst := image.NewRGBA(bounds)
+	draw.Draw(dst, bounds, image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, bounds, img, bounds.Min, draw.Over)
+
+	return dst
+}
+
+// stripImageMetadata removes the metadata from an image without re-encoding its image data.
+func stripImageMetadata(data []byte, format string) ([]byte, error) {
+	switch format {
+	case imageFormatPNG:
+		return stripPNGMetadata(data)
+	case imageFormatJPEG:
+		return stripJPEGMetadata(data)
+	case imageFormatGIF:
+		// GIF images are re-encoded, which keeps their frames but not their comments or application extensions.
+		img, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode image: %w", err)
+		}
+
+		encoded := &bytes.Buffer{}
+		if err := gif.EncodeAll(encoded, img); err != nil {
+			return nil, fmt.Errorf("unable to encode image as gif: %w", err)
+		}
+
+		return encoded.Bytes(), nil
+	}
+
+	return nil, fmt.Errorf("unable to remove metadata from %s images", format)
+}
+
+// stripPNGMetadata removes the text, EXIF, and time chunks from a PNG image.
+func stripPNGMetadata(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, fmt.Errorf("%w: missing PNG signature", errMalformedImage)
+	}
+
+	stripped := bytes.NewBuffer(make([]byte, 0, len(data)))
+	stripped.Write(pngSignature)
+
+	// Each chunk is its length, type, data, and checksum.
+	rest := data[len(pngSignature):]
+	for len(rest) > 0 {
+		if len(rest) < 12 {
+			return nil, fmt.Errorf("%w: truncated PNG chunk", errMalformedImage)
+		}
+
+		length := binary.BigEndian.Uint32(rest)
+		if uint64(length) > uint64(len(rest)-12) {
+			return nil, fmt.Errorf("%w: truncated PNG chunk", errMalformedImage)
+		}
+
+		size := 12 + int(length)
+		if !pngMetadataChunks[string(rest[4:8])] {
+			stripped.Write(rest[:size])
+		}
+
+		rest = rest[size:]
+	}
+
+	return stripped.Bytes(), nil
+}
+
+// stripJPEGMetadata removes the EXIF, XMP, IPTC, and comment segments from a JPEG image.
+func stripJPEGMetadata(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, fmt.Errorf("%w: missing JPEG start of image marker", errMalformedImage)
+	}
+
+	stripped := bytes.NewBuffer(make([]byte, 0, len(data)))
+	stripped.Write(data[:2])
+
+	// Each segment before the image data is a marker followed by its length and data.
+	rest := data[2:]
+	for {
+		if len(rest) < 2 || rest[0] != 0xFF {
+			return nil, fmt.Errorf("%w: invalid JPEG segment", errMalformedImage)
+		}
+
+		marker := rest[1]
+
+		switch {
+		case marker == 0xFF:
+			// Markers may be preceded by fill bytes.
+			rest = rest[1:]
+
+			continue
+		case marker == 0xDA || marker == 0xD9:
+			// The rest of the image is the image data after the start of scan marker, which has no metadata.
+			stripped.Write(rest)
+
+			return stripped.Bytes(), nil
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7):
+			// These markers don't have a length or data.
+			stripped.Write(rest[:2])
+			rest = rest[2:]
+
+			continue
+		}
+
+		if len(rest) < 4 {
+			return nil, fmt.Errorf("%w: truncated JPEG segment", errMalformedImage)
+		}
+
+		size := 2 + int(binary.BigEndian.Uint16(rest[2:]))
+		if size < 4 || size > len(rest) {
+			return nil, fmt.Errorf("%w: truncated JPEG segment", errMalformedImage)
+		}
+
+		if !jpegMetadataMarkers[marker] {
+			stripped.Write(rest[:size])
+		}
+
+		rest = rest[size:]
+	}
+}
@@ -4,15 +4,22 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha512"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,10 +29,15 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &imageResource{}
-	_ resource.ResourceWithConfigure = &imageResource{}
+	_ resource.Resource                   = &imageResource{}
+	_ resource.ResourceWithConfigure      = &imageResource{}
+	_ resource.ResourceWithModifyPlan     = &imageResource{}
+	_ resource.ResourceWithValidateConfig = &imageResource{}
 )
 
+// imageRequestTimeout is the maximum time to wait for a request to an image URL.
+const imageRequestTimeout = 30 * time.Second
+
 // imageResource is the resource implementation.
 type imageResource struct {
 	client *readme.Client
@@ -33,13 +45,27 @@ type imageResource struct {
 
 // imageResourceModel is the data structure used to hold the resource state.
 type imageResourceModel struct {
-	Color    types.String `tfsdk:"color"`
-	Filename types.String `tfsdk:"filename"`
-	Height   types.Int64  `tfsdk:"height"`
-	ID       types.String `tfsdk:"id"`
-	Source   types.String `tfsdk:"source"`
-	URL      types.String `tfsdk:"url"`
-	Width    types.Int64  `tfsdk:"width"`
+	Color         types.String   `tfsdk:"color"`
+	ContentBase64 types.String   `tfsdk:"content_base64"`
+	Filename      types.String   `tfsdk:"filename"`
+	Format        types.String   `tfsdk:"format"`
+	Height        types.Int64    `tfsdk:"height"`
+	ID            types.String   `tfsdk:"id"`
+	MaxHeight     types.Int64    `tfsdk:"max_height"`
+	MaxWidth      types.Int64    `tfsdk:"max_width"`
+	Quality       types.Int64    `tfsdk:"quality"`
+	Source        types.String   `tfsdk:"source"`
+	SourceURL     types.String   `tfsdk:"source_url"`
+	StripMetadata types.Bool     `tfsdk:"strip_metadata"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	URL           types.String   `tfsdk:"url"`
+	Width         types.Int64    `tfsdk:"width"`
+}
+
+// imageProcessing returns the options for processing the image before it's uploaded. The returned bool is false
+// if any of the options are unknown.
+func (m imageResourceModel) imageProcessing() (imageProcessing, bool) {
+	return newImageProcessing(m.MaxWidth, m.MaxHeight, m.Format, m.Quality, m.StripMetadata)
 }
 
 // NewImageResource is a helper function to simplify the provider implementation.
@@ -58,7 +84,37 @@ func (r *imageResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	r.client = req.ProviderData.(*readme.Client)
+	r.client = req.ProviderData.(*providerData).client
+}
+
+// ValidateConfig is used for validating attribute values.
+func (r *imageResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data imageResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateImageProcessing(data.MaxWidth, data.MaxHeight, data.Format, data.Quality)...)
+
+	// Exactly one image source must be set. Unknown values are set, they just aren't known yet.
+	sources := 0
+	for _, value := range []types.String{data.Source, data.ContentBase64, data.SourceURL} {
+		if !value.IsNull() {
+			sources++
+		}
+	}
+
+	if sources != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid image source.",
+			"Exactly one of source, content_base64, or source_url must be set.",
+		)
+	}
 }
 
 // openFile returns the contents of a file as bytes.
@@ -91,72 +147,192 @@ func openFile(src string) ([]byte, error) {
 	return data, nil
 }
 
-// sha256Sum returns the sha256 sum of a byte slice.
-func sha256Sum(src []byte) string {
-	sha_256 := sha512.New512_256()
-	sha_256.Write(src)
+// imageSource returns the contents of the image from the source that's set, with the filename to upload it as.
+//
+// Local files are read from disk, inline content is decoded from base64, and remote URLs are fetched with the
+// provider's HTTP client. Inline content is named "image" and remote images are named after the last element of
+// the URL path, with the extension of their content type if they don't have one.
+func (r *imageResource) imageSource(ctx context.Context, model imageResourceModel) ([]byte, string, error) {
+	switch {
+	case !model.ContentBase64.IsNull():
+		data, err := base64.StdEncoding.DecodeString(model.ContentBase64.ValueString())
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to decode content_base64: %w", err)
+		}
+
+		return data, imageFilename("image", data), nil
+	case !model.SourceURL.IsNull():
+		if r.client == nil {
+			return nil, "", errors.New("the provider must be configured to fetch remote images")
+		}
+
+		data, err := r.fetchImage(ctx, model.SourceURL.ValueString())
+		if err != nil {
+			return nil, "", err
+		}
+
+		name := "image"
+		if parsed, err := url.Parse(model.SourceURL.ValueString()); err == nil && filepath.Base(parsed.Path) != "/" &&
+			filepath.Base(parsed.Path) != "." {
+			name = filepath.Base(parsed.Path)
+		}
+
+		return data, imageFilename(name, data), nil
+	}
 
-	return fmt.Sprintf("%x", sha_256.Sum(nil))
+	data, err := openFile(model.Source.ValueString())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return data, model.Source.ValueString(), nil
 }
 
-// imageShasumModifier is a plan modifier that will set the shasum attribute to unknown if the source image has changed.
-type imageShasumModifier struct{}
+// imageFilename returns a filename with the extension of an image's content type if it doesn't have an extension.
+func imageFilename(name string, data []byte) string {
+	if filepath.Ext(name) != "" {
+		return name
+	}
+
+	_, ext, found := strings.Cut(http.DetectContentType(data), "image/")
+	if !found {
+		return name
+	}
 
-// imageShasumChanged is a plan modifier that will set the shasum attribute to unknown if the source image has changed.
-func imageShasumChanged() planmodifier.String {
-	return imageShasumModifier{}
+	return name + "." + ext
 }
 
-// Description returns a description of the plan modifier.
-func (m imageShasumModifier) Description(ctx context.Context) string {
-	return "Plan modifier that updates the shasum attribute if the source image has changed."
+// fetchImage returns the contents of a remote image, fetched with the provider's HTTP client.
+func (r *imageResource) fetchImage(ctx context.Context, imageURL string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, imageRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	res, err := r.client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %s: %w", imageURL, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch %s: received status code %d", imageURL, res.StatusCode)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", imageURL, err)
+	}
+
+	return data, nil
 }
 
-// MarkdownDescription returns a description of the plan modifier in Markdown format.
-func (m imageShasumModifier) MarkdownDescription(ctx context.Context) string {
-	return m.Description(ctx)
+// sha256Sum returns the sha256 sum of a byte slice.
+func sha256Sum(src []byte) string {
+	sha_256 := sha512.New512_256()
+	sha_256.Write(src)
+
+	return fmt.Sprintf("%x", sha_256.Sum(nil))
 }
 
-// PlanModifyString is called to modify the plan for a string attribute.
-func (m imageShasumModifier) PlanModifyString(
+// ModifyPlan sets the planned checksum to the checksum of the source image after it's processed.
+//
+// A changed image or a change to the processing options that changes the uploaded image is shown in the plan and
+// replaces the resource. The checksum is unknown if the source or the processing options aren't known until apply,
+// in which case an existing resource is replaced so the image is uploaded with the values known at apply.
+// Every source type is read the same way it's read when the image is uploaded, so remote images are fetched on
+// every plan.
+func (r *imageResource) ModifyPlan(
 	ctx context.Context,
-	req planmodifier.StringRequest,
-	resp *planmodifier.StringResponse,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
 ) {
-	var sourcePlanValue, sumStateValue types.String
-	// Retrieve the current source value from the plan.
-	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("source"), &sourcePlanValue)...)
+	plan := &imageResourceModel{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	state := &imageResourceModel{}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+
+	opts, known := plan.imageProcessing()
+	if plan.Source.IsUnknown() || plan.ContentBase64.IsUnknown() || plan.SourceURL.IsUnknown() || !known {
+		plan.ID = types.StringUnknown()
 
-	// Get the shasum (id) attribute from the state.
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &sumStateValue)...)
+		// The uploaded image may change with any of these values, including processing options that are set from
+		// other resources, so it's uploaded again.
+		if state != nil {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("id"))
+		}
 
-	// Open the source image file and calculate the sha512 sum.
-	sourceData, err := openFile(sourcePlanValue.ValueString())
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+
+		return
+	}
+
+	// Read the source image and calculate the sha512 sum of the processed image.
+	sourceData, filename, err := r.imageSource(ctx, *plan)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to get checksum for image file.", err.Error())
 
 		return
 	}
-	shaSum := sha256Sum(sourceData)
 
-	// If the source image has changed, set the shasum to unknown.
-	if shaSum != sumStateValue.ValueString() {
-		resp.PlanValue = types.StringUnknown()
+	imageData, _, err := processImage(sourceData, filename, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to process image file.", err.Error())
+
+		return
 	}
+
+	plan.ID = types.StringValue(sha256Sum(imageData))
+
+	if state != nil && !state.ID.Equal(plan.ID) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("id"))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
 // Schema defines the image resource attributes.
-func (r *imageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *imageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages Images on ReadMe.com\n\n" +
 			"The images API is not part of the official ReadMe API and therefore not documented or fully featured.\n\n" +
-			"Images are not truly stateful - the provider tracks the local source image for changes and will upload " +
-			"a new image if the source is changed. The provider makes a HEAD request to the image URL to verify its " +
-			"existence. Any change to the source path or checksum will trigger a resource replacement.",
+			"Images are not truly stateful - the provider tracks the source image for changes and will upload " +
+			"a new image if the source is changed. The image is read from a local file (`source`), inline base64 " +
+			"content (`content_base64`), or a remote URL (`source_url`), which is fetched on every plan. The " +
+			"provider makes a HEAD request to the image URL to verify its existence and removes the resource from " +
+			"the state if the image is not found. Any change to the source path or checksum will trigger a resource " +
+			"replacement, which uploads the new image and changes the `url` of the resource.\n\n" +
+			"Images can be resized, converted, and have their metadata removed before they're uploaded. The " +
+			"checksum is calculated from the processed image, so a change to the processing options only uploads a " +
+			"new image if the processed image changes.\n\n" +
+			"ReadMe does not support deleting images. Destroying the resource removes it from the Terraform state " +
+			"but the uploaded image remains available at its URL.",
 		Attributes: map[string]schema.Attribute{
 			"source": schema.StringAttribute{
-				Description: "The path to the local image source.",
-				Required:    true,
+				Description: "The path to the local image source. Exactly one of `source`, `content_base64`, or " +
+					"`source_url` must be set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				Description: "The base64-encoded contents of the image, such as an image generated by another " +
+					"resource. Exactly one of `source`, `content_base64`, or `source_url` must be set.",
+				Optional: true,
+			},
+			"source_url": schema.StringAttribute{
+				Description: "The URL of a remote image source, which is fetched with the provider's HTTP client. " +
+					"Exactly one of `source`, `content_base64`, or `source_url` must be set.",
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -164,32 +340,53 @@ func (r *imageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"color": schema.StringAttribute{
 				Description: "The color of the image.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"filename": schema.StringAttribute{
 				Description: "The filename of the image.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"height": schema.Int64Attribute{
 				Description: "The pixel height of the image.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"id": schema.StringAttribute{
-				Description: "The sha512sum of the source image.",
+				Description: "The sha512sum of the uploaded image, after it's processed.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					imageShasumChanged(),
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"url": schema.StringAttribute{
 				Description: "The URL of the uploaded image.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"width": schema.Int64Attribute{
 				Description: "The pixel width of the image.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+
+	for name, attribute := range imageProcessingSchema() {
+		resp.Schema.Attributes[name] = attribute
 	}
 }
 
@@ -203,16 +400,28 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Open the image source file.
-	imageData, err := openFile(plan.Source.ValueString())
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Create, operationCreate, &resp.Diagnostics)
+	defer done()
+
+	// Read the source image.
+	sourceData, filename, err := r.imageSource(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read source image file.", err.Error())
 
 		return
 	}
 
+	// Process the image before it's uploaded.
+	opts, _ := plan.imageProcessing()
+	imageData, filename, err := processImage(sourceData, filename, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to process image file.", err.Error())
+
+		return
+	}
+
 	// Create the image.
-	image, apiResponse, err := r.client.Image.Upload(imageData, plan.Source.ValueString())
+	image, apiResponse, err := r.client.Image.Upload(imageData, filename)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create image.", clientError(err, apiResponse))
 
@@ -220,15 +429,12 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Create the plan.
-	plan = imageResourceModel{
-		Color:    types.StringValue(image.Color),
-		Filename: types.StringValue(image.Filename),
-		Height:   types.Int64Value(int64(image.Height)),
-		ID:       types.StringValue(sha256Sum(imageData)),
-		Source:   plan.Source,
-		URL:      types.StringValue(image.URL),
-		Width:    types.Int64Value(int64(image.Width)),
-	}
+	plan.Color = types.StringValue(image.Color)
+	plan.Filename = types.StringValue(image.Filename)
+	plan.Height = types.Int64Value(int64(image.Height))
+	plan.ID = types.StringValue(sha256Sum(imageData))
+	plan.URL = types.StringValue(image.URL)
+	plan.Width = types.Int64Value(int64(image.Width))
 
 	// Set state to fully populated data.
 	diags = resp.State.Set(ctx, plan)
@@ -239,45 +445,102 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 // Read the remote state and refresh the Terraform state with the latest data.
+//
+// The images API doesn't support retrieving images, so a HEAD request to the image URL is used to verify that the
+// image exists. The resource is removed from the state if it doesn't.
 func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state.
-	var plan, state imageResourceModel
+	var state imageResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Read, operationRead, &resp.Diagnostics)
+	defer done()
+
 	// Check if image exists.
-	res, err := http.Head(state.URL.ValueString())
+	statusCode, err := r.imageStatus(ctx, state.URL.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to read remote image.", err.Error())
-	}
-	err = res.Body.Close()
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to read remote image.", err.Error())
-	}
+		resp.Diagnostics.AddError(
+			"Unable to read remote image.",
+			fmt.Sprintf("Unable to verify that the image exists at %s: %s", state.URL.ValueString(), err),
+		)
 
-	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Remove resource if image does not exist remotely.
-	if res.StatusCode == 404 {
+	if statusCode == http.StatusNotFound || statusCode == http.StatusGone {
 		resp.State.RemoveResource(ctx)
 
 		return
 	}
 
+	if statusCode >= http.StatusBadRequest {
+		resp.Diagnostics.AddError(
+			"Unable to read remote image.",
+			fmt.Sprintf("Unable to verify that the image exists at %s: received status code %d.",
+				state.URL.ValueString(), statusCode),
+		)
+
+		return
+	}
+
 	// Set refreshed state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Update is not supported for image resources.
+// imageStatus makes a HEAD request to an image URL with the provider's HTTP client and returns the response status
+// code.
+func (r *imageResource) imageStatus(ctx context.Context, url string) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, imageRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return 0, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	res, err := r.client.HTTPClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request failed: %w", err)
+	}
+
+	if err := res.Body.Close(); err != nil {
+		return 0, fmt.Errorf("unable to close response body: %w", err)
+	}
+
+	return res.StatusCode, nil
+}
+
+// Update saves the planned state.
+//
+// Every change to the uploaded image replaces the resource, so there's nothing to update on ReadMe. Changes to the
+// processing options that don't change the processed image are only saved to the state.
 func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan imageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-// Delete is not supported for image resources.
-// This removes the resource from state, but does not delete the image from ReadMe.
+// Delete removes the resource from the Terraform state.
+//
+// ReadMe doesn't support deleting images, so the image remains available at its URL.
 func (r *imageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state imageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Image was not deleted.",
+		fmt.Sprintf("The resource was removed from the Terraform state but the image remains on ReadMe at %s. "+
+			"ReadMe does not support deleting images.", state.URL.ValueString()),
+	)
 }
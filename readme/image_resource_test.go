@@ -1,11 +1,25 @@
 package readme
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"gopkg.in/h2non/gock.v1"
 )
 
@@ -87,6 +101,722 @@ func TestImageResource_Errors(t *testing.T) {
 				}`,
 				ExpectError: regexp.MustCompile("Unable to get checksum for image file"),
 			},
+			{
+				Config: providerConfig + `resource "readme_image" "test" {
+					source = "../examples/resources/readme_image/example.png"
+					format = "webp"
+				}`,
+				ExpectError: regexp.MustCompile("WebP images can't be uploaded"),
+			},
+			{
+				Config:      providerConfig + `resource "readme_image" "test" {}`,
+				ExpectError: regexp.MustCompile("Exactly one of source, content_base64, or source_url must be set"),
+			},
+			{
+				Config: providerConfig + `resource "readme_image" "test" {
+					source         = "../examples/resources/readme_image/example.png"
+					content_base64 = "aW1hZ2U="
+				}`,
+				ExpectError: regexp.MustCompile("Exactly one of source, content_base64, or source_url must be set"),
+			},
+			{
+				Config: providerConfig + `resource "readme_image" "test" {
+					content_base64 = "not base64!"
+				}`,
+				ExpectError: regexp.MustCompile("unable to decode content_base64"),
+			},
+			{
+				Config: providerConfig + `resource "readme_image" "test" {
+					source = "../examples/resources/readme_image/example.png"
+					format = "bmp"
+				}`,
+				ExpectError: regexp.MustCompile("format must be one of 'png' or 'jpeg', got 'bmp'"),
+			},
+			{
+				Config: providerConfig + `resource "readme_image" "test" {
+					source = "../examples/resources/readme_image/example.png"
+					quality = 0
+				}`,
+				ExpectError: regexp.MustCompile("quality must be between 1 and 100, got 0"),
+			},
+			{
+				Config: providerConfig + `resource "readme_image" "test" {
+					source = "../examples/resources/readme_image/example.png"
+					max_width = 0
+				}`,
+				ExpectError: regexp.MustCompile("max_width must be at least 1, got 0"),
+			},
+		},
+	})
+}
+
+func TestImageResource_Replace(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	source := filepath.Join(t.TempDir(), "example.png")
+	original, err := os.ReadFile("../examples/resources/readme_image/example.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The changed image is the original image with a trailing byte so that its checksum differs.
+	changed := append(append([]byte{}, original...), 0)
+
+	originalURL := "https://files.readme.io/c6f07db-example.png"
+	changedURL := "https://files.readme.io/d7a18ec-example.png"
+
+	// mockImage mocks an image upload and the requests that verify the image exists.
+	mockImage := func(url string) {
+		gock.OffAll()
+		gock.New("https://dash.readme.com/api/images").
+			Post("/image-upload").
+			Times(1).
+			Reply(200).
+			JSON([]any{url, "example.png", 1, 1, "#000000"})
+		gock.New(url).
+			Head("/").
+			Persist().
+			Reply(200)
+	}
+
+	config := providerConfig + fmt.Sprintf(`
+		resource "readme_image" "test" {
+			source = "%s"
+		}
+
+		output "url" {
+			value = readme_image.test.url
+		}`, source)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				PreConfig: func() {
+					if err := os.WriteFile(source, original, 0o600); err != nil {
+						t.Fatal(err)
+					}
+					mockImage(originalURL)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_image.test", "id", sha256Sum(original)),
+					resource.TestCheckResourceAttr("readme_image.test", "url", originalURL),
+					resource.TestCheckOutput("url", originalURL),
+				),
+			},
+			// Changing the image replaces the resource with the newly uploaded image.
+			{
+				Config: config,
+				PreConfig: func() {
+					if err := os.WriteFile(source, changed, 0o600); err != nil {
+						t.Fatal(err)
+					}
+					mockImage(changedURL)
+					gock.New(originalURL).
+						Head("/").
+						Persist().
+						Reply(200)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_image.test", "id", sha256Sum(changed)),
+					resource.TestCheckResourceAttr("readme_image.test", "url", changedURL),
+					resource.TestCheckOutput("url", changedURL),
+				),
+			},
+		},
+	})
+}
+
+func TestImageResource_Read(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	url := "https://files.readme.io/c6f07db-example.png"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "readme_image" "test" {
+					source = "../examples/resources/readme_image/example.png"
+				}`,
+				PreConfig: func() {
+					gock.New("https://dash.readme.com/api/images").
+						Post("/image-upload").
+						Times(1).
+						Reply(200).
+						JSON([]any{url, "example.png", 1, 1, "#000000"})
+					gock.New(url).
+						Head("/").
+						Times(1).
+						Reply(200)
+				},
+			},
+			// A network error is reported without changing the state.
+			{
+				Config: providerConfig + `resource "readme_image" "test" {
+					source = "../examples/resources/readme_image/example.png"
+				}`,
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(url).
+						Head("/").
+						Times(1).
+						ReplyError(errors.New("connection refused"))
+				},
+				ExpectError: regexp.MustCompile("Unable to read remote image"),
+			},
+			// An error status is reported without changing the state.
+			{
+				Config: providerConfig + `resource "readme_image" "test" {
+					source = "../examples/resources/readme_image/example.png"
+				}`,
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(url).
+						Head("/").
+						Times(1).
+						Reply(500)
+				},
+				ExpectError: regexp.MustCompile("received status code 500"),
+			},
+			// The resource is removed from the state if the image is not found.
+			{
+				Config: providerConfig + `resource "readme_image" "test" {
+					source = "../examples/resources/readme_image/example.png"
+				}`,
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(url).
+						Head("/").
+						Persist().
+						Reply(404)
+				},
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// writeTestImage writes a 40x20 PNG image with a text chunk to a directory and returns its path.
+func writeTestImage(t *testing.T, dir string) string {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, 40, 20))
+	for x := 0; x < 40; x++ {
+		for y := 0; y < 20; y++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x * 6), G: uint8(y * 12), B: 128, A: 255})
+		}
+	}
+
+	encoded := &bytes.Buffer{}
+	if err := png.Encode(encoded, img); err != nil {
+		t.Fatal(err)
+	}
+
+	// Insert a text chunk after the header chunk, which is 25 bytes after the signature.
+	text := []byte("tEXtComment\x00GPS 51.5074,-0.1278")
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(text)-4))
+	chunk = append(chunk, text...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(text))
+
+	data := encoded.Bytes()
+	data = append(append(append([]byte{}, data[:33]...), chunk...), data[33:]...)
+
+	source := filepath.Join(dir, "example.png")
+	if err := os.WriteFile(source, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return source
+}
+
+func TestImageResource_Processing(t *testing.T) {
+	url := "https://files.readme.io/c6f07db-example.png"
+
+	testCases := []struct {
+		name       string
+		attributes string
+		opts       imageProcessing
+		format     string
+		filename   string
+		width      int
+		height     int
+		metadata   bool
+	}{
+		{
+			name:       "resize",
+			attributes: "max_width = 10",
+			opts:       imageProcessing{MaxWidth: 10},
+			format:     imageFormatPNG,
+			filename:   "example.png",
+			width:      10,
+			height:     5,
+		},
+		{
+			name:       "fit within width and height",
+			attributes: "max_width = 20\nmax_height = 4",
+			opts:       imageProcessing{MaxWidth: 20, MaxHeight: 4},
+			format:     imageFormatPNG,
+			filename:   "example.png",
+			width:      8,
+			height:     4,
+		},
+		{
+			name:       "convert",
+			attributes: "format = \"jpeg\"\nquality = 80",
+			opts:       imageProcessing{Format: imageFormatJPEG, Quality: 80},
+			format:     imageFormatJPEG,
+			filename:   "example.jpeg",
+			width:      40,
+			height:     20,
+		},
+		{
+			name:       "strip metadata",
+			attributes: "strip_metadata = true",
+			opts:       imageProcessing{StripMetadata: true},
+			format:     imageFormatPNG,
+			filename:   "example.png",
+			width:      40,
+			height:     20,
+		},
+		{
+			name:       "unprocessed",
+			attributes: "max_width = 100",
+			opts:       imageProcessing{MaxWidth: 100},
+			format:     imageFormatPNG,
+			filename:   "example.png",
+			width:      40,
+			height:     20,
+			metadata:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Close all gocks after completion.
+			defer gock.OffAll()
+
+			source := writeTestImage(t, t.TempDir())
+			sourceData, err := os.ReadFile(source)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected, _, err := processImage(sourceData, source, testCase.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var uploaded []byte
+			var uploadedFilename string
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+							resource "readme_image" "test" {
+								source = "%s"
+								%s
+							}`, source, testCase.attributes),
+						PreConfig: func() {
+							gock.New("https://dash.readme.com/api/images").
+								Post("/image-upload").
+								AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+									if err := req.ParseMultipartForm(1 << 20); err != nil {
+										return false, err
+									}
+
+									file, header, err := req.FormFile("data")
+									if err != nil {
+										return false, err
+									}
+									defer file.Close()
+
+									uploaded, err = io.ReadAll(file)
+									uploadedFilename = header.Filename
+
+									return err == nil, err
+								}).
+								Times(1).
+								Reply(200).
+								JSON([]any{url, testCase.filename, testCase.width, testCase.height, "#000000"})
+							gock.New(url).
+								Head("/").
+								Persist().
+								Reply(200)
+						},
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("readme_image.test", "id", sha256Sum(expected)),
+							func(_ *terraform.State) error {
+								config, format, err := image.DecodeConfig(bytes.NewReader(uploaded))
+								if err != nil {
+									return err
+								}
+
+								if format != testCase.format || uploadedFilename != testCase.filename {
+									return fmt.Errorf("expected %s image %s, got %s image %s",
+										testCase.format, testCase.filename, format, uploadedFilename)
+								}
+
+								if config.Width != testCase.width || config.Height != testCase.height {
+									return fmt.Errorf("expected %dx%d image, got %dx%d",
+										testCase.width, testCase.height, config.Width, config.Height)
+								}
+
+								if bytes.Contains(uploaded, []byte("GPS")) != testCase.metadata {
+									return fmt.Errorf("expected metadata in the uploaded image to be %t",
+										testCase.metadata)
+								}
+
+								return nil
+							},
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+// Changing the processing options only replaces the image if the processed image changes.
+func TestImageResource_Processing_Changes(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	source := writeTestImage(t, t.TempDir())
+	url := "https://files.readme.io/c6f07db-example.png"
+	resizedURL := "https://files.readme.io/d7a18ec-example.png"
+
+	config := func(attributes string) string {
+		return providerConfig + fmt.Sprintf(`
+			resource "readme_image" "test" {
+				source = "%s"
+				%s
+			}`, source, attributes)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(""),
+				PreConfig: func() {
+					gock.New("https://dash.readme.com/api/images").
+						Post("/image-upload").
+						Times(1).
+						Reply(200).
+						JSON([]any{url, "example.png", 40, 20, "#000000"})
+					gock.New(url).
+						Head("/").
+						Persist().
+						Reply(200)
+				},
+				Check: resource.TestCheckResourceAttr("readme_image.test", "url", url),
+			},
+			// The image is already smaller than the maximum width, so it's not uploaded again.
+			{
+				Config: config("max_width = 100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_image.test", "url", url),
+					resource.TestCheckResourceAttr("readme_image.test", "max_width", "100"),
+				),
+			},
+			// The image is resized, so it's uploaded again.
+			{
+				Config: config("max_width = 10"),
+				PreConfig: func() {
+					gock.New("https://dash.readme.com/api/images").
+						Post("/image-upload").
+						Times(1).
+						Reply(200).
+						JSON([]any{resizedURL, "example.png", 10, 5, "#000000"})
+					gock.New(resizedURL).
+						Head("/").
+						Persist().
+						Reply(200)
+				},
+				Check: resource.TestCheckResourceAttr("readme_image.test", "url", resizedURL),
+			},
+		},
+	})
+}
+
+func TestImageResource_Processing_Unknown(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	source := writeTestImage(t, t.TempDir())
+	url := "https://files.readme.io/c6f07db-example.png"
+	resizedURL := "https://files.readme.io/d7a18ec-example.png"
+
+	// The maximum width is the output of another resource, which isn't known until apply when its input changes.
+	config := func(maxWidth int) string {
+		return providerConfig + fmt.Sprintf(`
+			resource "terraform_data" "max_width" {
+				input = %d
+			}
+
+			resource "readme_image" "test" {
+				source    = "%s"
+				max_width = terraform_data.max_width.output
+			}`, maxWidth, source)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(100),
+				PreConfig: func() {
+					gock.New("https://dash.readme.com/api/images").
+						Post("/image-upload").
+						Times(1).
+						Reply(200).
+						JSON([]any{url, "example.png", 40, 20, "#000000"})
+					gock.New(url).
+						Head("/").
+						Persist().
+						Reply(200)
+				},
+				Check: resource.TestCheckResourceAttr("readme_image.test", "url", url),
+			},
+			// The changed maximum width is unknown when planning, so the resource is replaced and the image is
+			// uploaded with the width that's known at apply.
+			{
+				Config: config(10),
+				PreConfig: func() {
+					gock.New("https://dash.readme.com/api/images").
+						Post("/image-upload").
+						Times(1).
+						Reply(200).
+						JSON([]any{resizedURL, "example.png", 10, 5, "#000000"})
+					gock.New(resizedURL).
+						Head("/").
+						Persist().
+						Reply(200)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_image.test", "url", resizedURL),
+					resource.TestCheckResourceAttr("readme_image.test", "max_width", "10"),
+					resource.TestCheckResourceAttr("readme_image.test", "width", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestImageResource_ContentBase64(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	data, err := os.ReadFile("../examples/resources/readme_image/example.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	url := "https://files.readme.io/c6f07db-image.png"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`resource "readme_image" "test" {
+					content_base64 = "%s"
+				}`, base64.StdEncoding.EncodeToString(data)),
+				PreConfig: func() {
+					gock.New("https://dash.readme.com/api/images").
+						Post("/image-upload").
+						BodyString(`filename="image.png"`).
+						Times(1).
+						Reply(200).
+						JSON([]any{url, "image.png", 1, 1, "#000000"})
+					gock.New(url).
+						Head("/").
+						Persist().
+						Reply(200)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_image.test", "id", sha256Sum(data)),
+					resource.TestCheckResourceAttr("readme_image.test", "url", url),
+					resource.TestCheckNoResourceAttr("readme_image.test", "source"),
+				),
+			},
+		},
+	})
+}
+
+func TestImageResource_ContentBase64_Unknown(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	original, err := os.ReadFile("../examples/resources/readme_image/example.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The changed image is the original image with a trailing byte so that its checksum differs.
+	changed := append(append([]byte{}, original...), 0)
+
+	originalURL := "https://files.readme.io/c6f07db-image.png"
+	changedURL := "https://files.readme.io/d7a18ec-image.png"
+
+	// mockImage mocks an image upload and the requests that verify the image exists.
+	mockImage := func(url string) {
+		gock.New("https://dash.readme.com/api/images").
+			Post("/image-upload").
+			Times(1).
+			Reply(200).
+			JSON([]any{url, "image.png", 1, 1, "#000000"})
+		gock.New(url).
+			Head("/").
+			Persist().
+			Reply(200)
+	}
+
+	// The content is the output of another resource, which isn't known until apply when its input changes.
+	config := func(data []byte) string {
+		return providerConfig + fmt.Sprintf(`
+			resource "terraform_data" "content" {
+				input = "%s"
+			}
+
+			resource "readme_image" "test" {
+				content_base64 = terraform_data.content.output
+			}`, base64.StdEncoding.EncodeToString(data))
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:    config(original),
+				PreConfig: func() { mockImage(originalURL) },
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_image.test", "id", sha256Sum(original)),
+					resource.TestCheckResourceAttr("readme_image.test", "url", originalURL),
+				),
+			},
+			// The changed content is unknown when planning, so the resource is replaced and the image is uploaded.
+			{
+				Config: config(changed),
+				PreConfig: func() {
+					mockImage(changedURL)
+					gock.New(originalURL).
+						Head("/").
+						Persist().
+						Reply(200)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_image.test", "id", sha256Sum(changed)),
+					resource.TestCheckResourceAttr("readme_image.test", "url", changedURL),
+				),
+			},
+		},
+	})
+}
+
+func TestImageResource_SourceURL(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	original, err := os.ReadFile("../examples/resources/readme_image/example.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The changed image is the original image with a trailing byte so that its checksum differs.
+	changed := append(append([]byte{}, original...), 0)
+	served := original
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/diagrams/flow" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = w.Write(served)
+	}))
+	defer server.Close()
+
+	// Requests to the test server aren't mocked.
+	gock.EnableNetworking()
+	gock.NetworkingFilter(func(req *http.Request) bool {
+		return "http://"+req.URL.Host == server.URL
+	})
+	defer gock.DisableNetworkingFilters()
+	defer gock.DisableNetworking()
+
+	originalURL := "https://files.readme.io/c6f07db-flow.png"
+	changedURL := "https://files.readme.io/d7a18ec-flow.png"
+
+	// mockImage mocks an image upload and the requests that verify the image exists.
+	mockImage := func(url string) {
+		gock.OffAll()
+		gock.New("https://dash.readme.com/api/images").
+			Post("/image-upload").
+			BodyString(`filename="flow.png"`).
+			Times(1).
+			Reply(200).
+			JSON([]any{url, "flow.png", 1, 1, "#000000"})
+		gock.New(url).
+			Head("/").
+			Persist().
+			Reply(200)
+	}
+
+	config := providerConfig + fmt.Sprintf(`
+		resource "readme_image" "test" {
+			source_url = "%s/diagrams/flow"
+		}`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					resource "readme_image" "test" {
+						source_url = "%s/missing.png"
+					}`, server.URL),
+				ExpectError: regexp.MustCompile("received status code 404"),
+			},
+			{
+				Config:    config,
+				PreConfig: func() { mockImage(originalURL) },
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_image.test", "id", sha256Sum(original)),
+					resource.TestCheckResourceAttr("readme_image.test", "url", originalURL),
+				),
+			},
+			// Changing the remote image replaces the resource with the newly uploaded image.
+			{
+				Config: config,
+				PreConfig: func() {
+					served = changed
+					mockImage(changedURL)
+					gock.New(originalURL).
+						Head("/").
+						Persist().
+						Reply(200)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_image.test", "id", sha256Sum(changed)),
+					resource.TestCheckResourceAttr("readme_image.test", "url", changedURL),
+				),
+			},
 		},
 	})
 }
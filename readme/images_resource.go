@@ -0,0 +1,550 @@
+package readme
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &imagesResource{}
+	_ resource.ResourceWithConfigure      = &imagesResource{}
+	_ resource.ResourceWithModifyPlan     = &imagesResource{}
+	_ resource.ResourceWithValidateConfig = &imagesResource{}
+)
+
+// defaultImagePatterns are the file name patterns of the images that are uploaded by default.
+var defaultImagePatterns = []string{"*.png", "*.jpg", "*.jpeg", "*.gif"}
+
+// imagesFileType is the type of the uploaded images in the `images` attribute.
+var imagesFileType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"color":  types.StringType,
+		"height": types.Int64Type,
+		"url":    types.StringType,
+		"width":  types.Int64Type,
+	},
+}
+
+// imagesResource is the resource implementation.
+type imagesResource struct {
+	client *readme.Client
+}
+
+// imagesResourceModel is the data structure used to hold the resource state.
+type imagesResourceModel struct {
+	Checksums     types.Map      `tfsdk:"checksums"`
+	Directory     types.String   `tfsdk:"directory"`
+	Format        types.String   `tfsdk:"format"`
+	ID            types.String   `tfsdk:"id"`
+	Images        types.Map      `tfsdk:"images"`
+	MaxHeight     types.Int64    `tfsdk:"max_height"`
+	MaxWidth      types.Int64    `tfsdk:"max_width"`
+	Parallelism   types.Int64    `tfsdk:"parallelism"`
+	Patterns      types.List     `tfsdk:"patterns"`
+	Quality       types.Int64    `tfsdk:"quality"`
+	StripMetadata types.Bool     `tfsdk:"strip_metadata"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// imagesFileModel is an uploaded image in the `images` attribute.
+type imagesFileModel struct {
+	Color  types.String `tfsdk:"color"`
+	Height types.Int64  `tfsdk:"height"`
+	URL    types.String `tfsdk:"url"`
+	Width  types.Int64  `tfsdk:"width"`
+}
+
+// imageFile is an image file in the directory, processed and ready to upload.
+type imageFile struct {
+	// Path is the path of the file relative to the directory, with forward slashes.
+	Path string
+	// Filename is the filename to upload the processed image as.
+	Filename string
+	// Data is the processed image.
+	Data []byte
+	// SHA256 is the checksum of the processed image.
+	SHA256 string
+}
+
+// imageUpload is the result of uploading an image file.
+type imageUpload struct {
+	file  imageFile
+	image readme.Image
+	err   error
+}
+
+// NewImagesResource is a helper function to simplify the provider implementation.
+func NewImagesResource() resource.Resource {
+	return &imagesResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *imagesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_images"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *imagesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerData).client
+}
+
+// imageProcessing returns the options for processing the images before they're uploaded. The returned bool is false
+// if any of the options are unknown.
+func (m imagesResourceModel) imageProcessing() (imageProcessing, bool) {
+	return newImageProcessing(m.MaxWidth, m.MaxHeight, m.Format, m.Quality, m.StripMetadata)
+}
+
+// patterns returns the file name patterns of the images to upload. The returned bool is false if the patterns are
+// unknown.
+func (m imagesResourceModel) patterns(ctx context.Context) ([]string, bool) {
+	if m.Patterns.IsUnknown() {
+		return nil, false
+	}
+
+	if m.Patterns.IsNull() {
+		return defaultImagePatterns, true
+	}
+
+	patterns := []string{}
+	m.Patterns.ElementsAs(ctx, &patterns, false)
+
+	return patterns, true
+}
+
+// ValidateConfig is used for validating attribute values.
+func (r *imagesResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data imagesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateImageProcessing(data.MaxWidth, data.MaxHeight, data.Format, data.Quality)...)
+
+	if !data.Parallelism.IsNull() && !data.Parallelism.IsUnknown() && data.Parallelism.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parallelism"),
+			"Invalid parallelism.",
+			fmt.Sprintf("parallelism must be at least 1, got %d.", data.Parallelism.ValueInt64()),
+		)
+	}
+
+	patterns, known := data.patterns(ctx)
+	if !known {
+		return
+	}
+
+	for _, pattern := range patterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("patterns"),
+				"Invalid file name pattern.",
+				fmt.Sprintf("The pattern '%s' is invalid: %s.", pattern, err),
+			)
+		}
+	}
+}
+
+// Schema defines the images resource attributes.
+func (r *imagesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	patterns := []attr.Value{}
+	for _, pattern := range defaultImagePatterns {
+		patterns = append(patterns, types.StringValue(pattern))
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a directory of images on ReadMe.com\n\n" +
+			"Every image in a directory and its subdirectories that matches the file name patterns is uploaded to " +
+			"ReadMe. Like the `readme_image` resource, the images API is not part of the official ReadMe API and " +
+			"therefore not documented or fully featured.\n\n" +
+			"Images are tracked by the checksum of the processed image and only changed images are uploaded " +
+			"again. Images are uploaded in parallel. The provider does not verify that uploaded images still exist " +
+			"when the resource is refreshed.\n\n" +
+			"ReadMe does not support deleting images. Images that are removed from the directory or destroyed are " +
+			"removed from the Terraform state but remain available at their URLs.",
+		Attributes: map[string]schema.Attribute{
+			"checksums": schema.MapAttribute{
+				Description: "A map of the paths of the uploaded images, relative to `directory`, to the sha512sum " +
+					"of each processed image.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"directory": schema.StringAttribute{
+				Description: "The path to the local directory of images.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The path to the local directory of images.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"images": schema.MapAttribute{
+				Description: "A map of the paths of the uploaded images, relative to `directory` and separated with " +
+					"forward slashes, to their `url`, pixel `width` and `height`, and `color` on ReadMe.",
+				Computed:    true,
+				ElementType: imagesFileType,
+			},
+			"parallelism": schema.Int64Attribute{
+				Description: "The maximum number of images that are uploaded at the same time. Defaults to `4`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(4),
+			},
+			"patterns": schema.ListAttribute{
+				Description: "The patterns of the image files to upload. Patterns are matched against the file " +
+					"name, or against the path relative to `directory` if they contain a `/`. Defaults to " +
+					"`[\"*.png\", \"*.jpg\", \"*.jpeg\", \"*.gif\"]`.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, patterns)),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+
+	for name, attribute := range imageProcessingSchema() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+// findImageFiles returns the processed image files in a directory and its subdirectories that match the patterns.
+// The files are sorted by their path.
+func findImageFiles(directory string, patterns []string, opts imageProcessing) ([]imageFile, error) {
+	files := []imageFile{}
+
+	err := filepath.WalkDir(directory, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(directory, file)
+		if err != nil {
+			return fmt.Errorf("unable to get relative path: %w", err)
+		}
+
+		rel = filepath.ToSlash(rel)
+		if !matchImagePatterns(rel, patterns) {
+			return nil
+		}
+
+		sourceData, err := openFile(file)
+		if err != nil {
+			return err
+		}
+
+		data, filename, err := processImage(sourceData, file, opts)
+		if err != nil {
+			return fmt.Errorf("unable to process image %s: %w", rel, err)
+		}
+
+		files = append(files, imageFile{Path: rel, Filename: filename, Data: data, SHA256: sha256Sum(data)})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read images in %s: %w", directory, err)
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+
+	return files, nil
+}
+
+// matchImagePatterns returns true if a relative path matches any of the patterns. Patterns that contain a `/` are
+// matched against the path and other patterns are matched against the file name.
+func matchImagePatterns(rel string, patterns []string) bool {
+	for _, pattern := range patterns {
+		name := filepath.Base(rel)
+		if strings.Contains(pattern, "/") {
+			name = rel
+		}
+
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stateImages returns the previously uploaded images and their checksums from the state, keyed by their paths.
+func stateImages(ctx context.Context, state *imagesResourceModel) (map[string]imagesFileModel, map[string]string) {
+	images := map[string]imagesFileModel{}
+	checksums := map[string]string{}
+
+	if state == nil {
+		return images, checksums
+	}
+
+	if !state.Images.IsNull() && !state.Images.IsUnknown() {
+		state.Images.ElementsAs(ctx, &images, false)
+	}
+
+	if !state.Checksums.IsNull() && !state.Checksums.IsUnknown() {
+		state.Checksums.ElementsAs(ctx, &checksums, false)
+	}
+
+	return images, checksums
+}
+
+// ModifyPlan plans the checksums of the images in the directory.
+//
+// Images whose checksums match the state are not uploaded again, so their values are known at plan time. The
+// values of new and changed images are unknown until they're uploaded.
+func (r *imagesResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	plan := &imagesResourceModel{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	state := &imagesResourceModel{}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+
+	// The ID is the path to the directory, which is unknown if the path isn't known until apply.
+	plan.ID = plan.Directory
+
+	opts, optsKnown := plan.imageProcessing()
+	patterns, patternsKnown := plan.patterns(ctx)
+
+	if plan.Directory.IsUnknown() || !optsKnown || !patternsKnown {
+		plan.Checksums = types.MapUnknown(types.StringType)
+		plan.Images = types.MapUnknown(imagesFileType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+
+		return
+	}
+
+	files, err := findImageFiles(plan.Directory.ValueString(), patterns, opts)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("directory"), "Unable to read images.", err.Error())
+
+		return
+	}
+
+	priorImages, priorChecksums := stateImages(ctx, state)
+	checksums := map[string]string{}
+	images := map[string]attr.Value{}
+
+	for _, file := range files {
+		checksums[file.Path] = file.SHA256
+		images[file.Path] = types.ObjectUnknown(imagesFileType.AttrTypes)
+
+		if image, ok := priorImages[file.Path]; ok && priorChecksums[file.Path] == file.SHA256 {
+			value, diags := types.ObjectValueFrom(ctx, imagesFileType.AttrTypes, image)
+			resp.Diagnostics.Append(diags...)
+			images[file.Path] = value
+		}
+	}
+
+	checksumsValue, diags := types.MapValueFrom(ctx, types.StringType, checksums)
+	resp.Diagnostics.Append(diags...)
+
+	imagesValue, diags := types.MapValue(imagesFileType, images)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Checksums = checksumsValue
+	plan.Images = imagesValue
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// uploadImageFiles uploads image files with a pool of workers and returns the result of each upload, in the order
+// of the files.
+func uploadImageFiles(client *readme.Client, files []imageFile, parallelism int) []imageUpload {
+	uploads := make([]imageUpload, len(files))
+
+	forEachParallel(len(files), parallelism, func(index int) {
+		file := files[index]
+
+		image, apiResponse, err := client.Image.Upload(file.Data, file.Filename)
+		if err != nil {
+			err = fmt.Errorf("unable to upload image %s: %s", file.Path, clientError(err, apiResponse))
+		}
+
+		uploads[index] = imageUpload{file: file, image: image, err: err}
+	})
+
+	return uploads
+}
+
+// save uploads the new and changed images in the directory and returns the resource state.
+//
+// Images whose checksums match the prior state are not uploaded again. If an image fails to upload, its prior
+// value is kept in the state so that it's uploaded again on the next apply, and an error is returned.
+func (r *imagesResource) save(
+	ctx context.Context,
+	plan imagesResourceModel,
+	state *imagesResourceModel,
+) (imagesResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts, _ := plan.imageProcessing()
+	patterns, _ := plan.patterns(ctx)
+
+	files, err := findImageFiles(plan.Directory.ValueString(), patterns, opts)
+	if err != nil {
+		diags.AddAttributeError(path.Root("directory"), "Unable to read images.", err.Error())
+
+		return plan, diags
+	}
+
+	priorImages, priorChecksums := stateImages(ctx, state)
+	images := map[string]imagesFileModel{}
+	checksums := map[string]string{}
+	changed := []imageFile{}
+
+	for _, file := range files {
+		image, ok := priorImages[file.Path]
+		if ok && priorChecksums[file.Path] == file.SHA256 {
+			images[file.Path] = image
+			checksums[file.Path] = file.SHA256
+
+			continue
+		}
+
+		changed = append(changed, file)
+	}
+
+	for _, upload := range uploadImageFiles(r.client, changed, int(plan.Parallelism.ValueInt64())) {
+		if upload.err != nil {
+			diags.AddError("Unable to upload image.", upload.err.Error())
+
+			if image, ok := priorImages[upload.file.Path]; ok {
+				images[upload.file.Path] = image
+				checksums[upload.file.Path] = priorChecksums[upload.file.Path]
+			}
+
+			continue
+		}
+
+		images[upload.file.Path] = imagesFileModel{
+			Color:  types.StringValue(upload.image.Color),
+			Height: types.Int64Value(upload.image.Height),
+			URL:    types.StringValue(upload.image.URL),
+			Width:  types.Int64Value(upload.image.Width),
+		}
+		checksums[upload.file.Path] = upload.file.SHA256
+	}
+
+	imagesValue, valueDiags := types.MapValueFrom(ctx, imagesFileType, images)
+	diags.Append(valueDiags...)
+
+	checksumsValue, valueDiags := types.MapValueFrom(ctx, types.StringType, checksums)
+	diags.Append(valueDiags...)
+
+	plan.ID = plan.Directory
+	plan.Images = imagesValue
+	plan.Checksums = checksumsValue
+
+	return plan, diags
+}
+
+// Create uploads the images in the directory and sets the initial Terraform state.
+func (r *imagesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan imagesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Create, operationCreate, &resp.Diagnostics)
+	defer done()
+
+	state, diags := r.save(ctx, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state.
+//
+// The images API doesn't support retrieving images, so the state is not changed.
+func (r *imagesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state imagesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update uploads the new and changed images in the directory and updates the Terraform state.
+func (r *imagesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state imagesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Update, operationUpdate, &resp.Diagnostics)
+	defer done()
+
+	saved, diags := r.save(ctx, plan, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, saved)...)
+}
+
+// Delete removes the resource from the Terraform state.
+//
+// ReadMe doesn't support deleting images, so the images remain available at their URLs.
+func (r *imagesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state imagesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Images were not deleted.",
+		fmt.Sprintf("The resource was removed from the Terraform state but the %d images from %s remain on "+
+			"ReadMe. ReadMe does not support deleting images.",
+			len(state.Images.Elements()), state.Directory.ValueString()),
+	)
+}
@@ -0,0 +1,193 @@
+package readme
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestImagesResource(t *testing.T) {
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	original, err := os.ReadFile("../examples/resources/readme_image/example.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each image is the example image with a different trailing byte so that their checksums differ.
+	imageData := func(suffix byte) []byte {
+		return append(append([]byte{}, original...), suffix)
+	}
+
+	directory := t.TempDir()
+	movedDirectory := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(directory, "shots"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile := func(name string, data []byte) {
+		if err := os.WriteFile(filepath.Join(directory, name), data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// mockUpload mocks the upload of an image file.
+	mockUpload := func(filename, url string) {
+		gock.New("https://dash.readme.com/api/images").
+			Post("/image-upload").
+			BodyString(regexp.QuoteMeta(fmt.Sprintf(`filename="%s"`, filename))).
+			Times(1).
+			Reply(200).
+			JSON([]any{url, filename, 1, 1, "#000000"})
+	}
+
+	config := providerConfig + fmt.Sprintf(`
+		resource "readme_images" "test" {
+			directory   = "%s"
+			parallelism = 2
+		}`, directory)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				PreConfig: func() {
+					writeFile("logo.png", imageData(1))
+					writeFile("shots/setup.png", imageData(2))
+					writeFile("shots/notes.txt", []byte("Not an image."))
+					mockUpload("logo.png", "https://files.readme.io/a1-logo.png")
+					mockUpload("setup.png", "https://files.readme.io/b1-setup.png")
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_images.test", "id", directory),
+					resource.TestCheckResourceAttr("readme_images.test", "images.%", "2"),
+					resource.TestCheckResourceAttr(
+						"readme_images.test", "images.logo.png.url", "https://files.readme.io/a1-logo.png"),
+					resource.TestCheckResourceAttr("readme_images.test", "images.logo.png.width", "1"),
+					resource.TestCheckResourceAttr("readme_images.test", "images.logo.png.height", "1"),
+					resource.TestCheckResourceAttr("readme_images.test", "images.logo.png.color", "#000000"),
+					resource.TestCheckResourceAttr(
+						"readme_images.test", "images.shots/setup.png.url", "https://files.readme.io/b1-setup.png"),
+					resource.TestCheckResourceAttr(
+						"readme_images.test", "checksums.logo.png", sha256Sum(imageData(1))),
+					resource.TestCheckResourceAttr(
+						"readme_images.test", "checksums.shots/setup.png", sha256Sum(imageData(2))),
+				),
+			},
+			// Only the changed image is uploaded again.
+			{
+				Config: config,
+				PreConfig: func() {
+					writeFile("shots/setup.png", imageData(3))
+					mockUpload("setup.png", "https://files.readme.io/b2-setup.png")
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"readme_images.test", "images.logo.png.url", "https://files.readme.io/a1-logo.png"),
+					resource.TestCheckResourceAttr(
+						"readme_images.test", "images.shots/setup.png.url", "https://files.readme.io/b2-setup.png"),
+					resource.TestCheckResourceAttr(
+						"readme_images.test", "checksums.shots/setup.png", sha256Sum(imageData(3))),
+				),
+			},
+			// Removed images are removed from the state without uploading the other images again.
+			{
+				Config: config,
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(directory, "logo.png")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_images.test", "images.%", "1"),
+					resource.TestCheckResourceAttr(
+						"readme_images.test", "images.shots/setup.png.url", "https://files.readme.io/b2-setup.png"),
+				),
+			},
+			// Moving the images to another directory changes the ID without uploading the unchanged images again.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					resource "readme_images" "test" {
+						directory   = "%s"
+						parallelism = 2
+					}`, movedDirectory),
+				PreConfig: func() {
+					if err := os.MkdirAll(filepath.Join(movedDirectory, "shots"), 0o700); err != nil {
+						t.Fatal(err)
+					}
+
+					err := os.WriteFile(filepath.Join(movedDirectory, "shots/setup.png"), imageData(3), 0o600)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_images.test", "id", movedDirectory),
+					resource.TestCheckResourceAttr("readme_images.test", "images.%", "1"),
+					resource.TestCheckResourceAttr(
+						"readme_images.test", "images.shots/setup.png.url", "https://files.readme.io/b2-setup.png"),
+				),
+			},
+			// Patterns with a slash are matched against the relative path.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					resource "readme_images" "test" {
+						directory = "%s"
+						patterns  = ["shots/*.txt", "*.png"]
+					}`, directory),
+				ExpectError: regexp.MustCompile(`unable to\s+process image shots/notes.txt`),
+			},
+		},
+	})
+}
+
+func TestImagesResource_Errors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config string
+		error  string
+	}{
+		{
+			name:   "parallelism",
+			config: "parallelism = 0",
+			error:  "parallelism must be at least 1, got 0",
+		},
+		{
+			name:   "pattern",
+			config: `patterns = ["[.png"]`,
+			error:  "The pattern '\\[.png' is invalid",
+		},
+		{
+			name:   "format",
+			config: `format = "webp"`,
+			error:  "WebP images can't be uploaded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+							resource "readme_images" "test" {
+								directory = "../examples/resources/readme_image"
+								%s
+							}`, testCase.config),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package readme
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// includeDescription is appended to the description of the `body` attribute of resources that expand include
+// directives.
+const includeDescription = " Use `{{< include \"path/to/file\" >}}` to embed the contents of a local file, or " +
+	"`{{< include \"path/to/file\" region=\"name\" >}}` to embed a region of it. Regions are marked with " +
+	"`region name` and `endregion name` comments, such as `// #region auth` and `// #endregion auth`. Relative " +
+	"paths are resolved from the directory of `source_file`, or the current working directory if `source_file` " +
+	"isn't set. Includes are expanded during the plan and changes to included files are shown as changes to " +
+	"`body_clean`."
+
+var (
+	// includeRegexp matches an include directive and captures the file path and the optional region name.
+	includeRegexp = regexp.MustCompile(`\{\{<\s*include\s+"([^"]+)"(?:\s+region="([^"]+)")?\s*>\}\}`)
+
+	// regionMarkerRegexp matches a line that starts or ends a region in an included file, such as
+	// `// #region auth` or `# endregion auth`. It captures the marker type and region name.
+	regionMarkerRegexp = regexp.MustCompile(
+		`^\s*(?://|#|--|;|/?\*|<!--)\s*#?(region|endregion)\s+([A-Za-z0-9_.-]+)`,
+	)
+)
+
+// includeBaseDir returns the directory that relative include paths are resolved from.
+func includeBaseDir(sourceFile types.String) string {
+	if sourceFile.ValueString() != "" {
+		return filepath.Dir(sourceFile.ValueString())
+	}
+
+	return "."
+}
+
+// expandIncludes replaces the include directives in a body with the contents of the included files.
+// Included files are not expanded recursively.
+func expandIncludes(body, baseDir string) (string, error) {
+	var expandErr error
+
+	expanded := includeRegexp.ReplaceAllStringFunc(body, func(match string) string {
+		if expandErr != nil {
+			return match
+		}
+
+		parts := includeRegexp.FindStringSubmatch(match)
+
+		content, err := includeFile(filepath.Join(baseDir, filepath.FromSlash(parts[1])), parts[2])
+		if err != nil {
+			expandErr = fmt.Errorf("unable to include %s: %w", parts[1], err)
+
+			return match
+		}
+
+		return content
+	})
+
+	if expandErr != nil {
+		return "", expandErr
+	}
+
+	return expanded, nil
+}
+
+// includeFile returns the contents of an included file, or of a region of it if a region name is given.
+// Region marker lines are removed and the trailing newline is trimmed.
+func includeFile(file, region string) (string, error) {
+	data, err := openFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+
+	if region != "" {
+		lines, err = regionLines(lines, region)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	out := []string{}
+	for _, line := range lines {
+		if !regionMarkerRegexp.MatchString(line) {
+			out = append(out, line)
+		}
+	}
+
+	return strings.Join(dedent(out), "\n"), nil
+}
+
+// regionLines returns the lines between the start and end markers of a region.
+func regionLines(lines []string, region string) ([]string, error) {
+	start := -1
+
+	for i, line := range lines {
+		match := regionMarkerRegexp.FindStringSubmatch(line)
+		if match == nil || match[2] != region {
+			continue
+		}
+
+		switch {
+		case match[1] == "region" && start == -1:
+			start = i + 1
+		case match[1] == "endregion" && start != -1:
+			return lines[start:i], nil
+		}
+	}
+
+	if start != -1 {
+		return nil, fmt.Errorf("region %q is not closed", region)
+	}
+
+	return nil, fmt.Errorf("region %q not found", region)
+}
+
+// dedent removes the leading whitespace that is common to all non-blank lines.
+func dedent(lines []string) []string {
+	prefix := ""
+	first := true
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+
+		switch {
+		case first:
+			prefix, first = indent, false
+		default:
+			for !strings.HasPrefix(indent, prefix) {
+				prefix = prefix[:len(prefix)-1]
+			}
+		}
+	}
+
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		out[i] = strings.TrimPrefix(line, prefix)
+	}
+
+	return out
+}
+
+// planIncludes expands the include directives in a body during the plan. Errors are reported on the
+// `source_file` attribute if it's set, or the `body` attribute otherwise.
+func planIncludes(body string, sourceFile types.String) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	expanded, err := expandIncludes(body, includeBaseDir(sourceFile))
+	if err != nil {
+		attribute := path.Root("body")
+		if sourceFile.ValueString() != "" {
+			attribute = path.Root("source_file")
+		}
+
+		diags.AddAttributeError(attribute, "Unable to expand include.", err.Error())
+	}
+
+	return expanded, diags
+}
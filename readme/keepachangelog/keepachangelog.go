@@ -0,0 +1,137 @@
+// package keepachangelog parses changelog files in the Keep a Changelog format into releases.
+//
+// Each release starts with a level 2 heading that names the version and optionally the release date, such as
+// `## [1.2.0] - 2024-01-02` or `## [Unreleased]`. The changes in a release are grouped under level 3 headings, such
+// as `### Added` or `### Fixed`. Content before the first release, such as the title and introduction, and the link
+// reference definitions of the releases are not part of any release. Headings in fenced code blocks are ignored.
+//
+// See <https://keepachangelog.com> for more information about the format.
+package keepachangelog
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Unreleased is the version of the release that collects upcoming changes.
+const Unreleased = "Unreleased"
+
+var (
+	// releaseRegexp matches a release heading, such as `## [1.2.0] - 2024-01-02`, and captures the version and date.
+	releaseRegexp = regexp.MustCompile(`^##\s+\[?([^\]\s]+)\]?(?:\s+-\s+(\S+))?.*$`)
+
+	// sectionRegexp matches a section heading, such as `### Added`, and captures the section name.
+	sectionRegexp = regexp.MustCompile(`^###\s+(.+?)\s*#*$`)
+
+	// linkReferenceRegexp matches a link reference definition for a release, such as
+	// `[1.2.0]: https://github.com/example/example/compare/v1.1.0...v1.2.0`.
+	linkReferenceRegexp = regexp.MustCompile(`^\s{0,3}\[[^\]]+\]:\s+\S+`)
+
+	// fenceRegexp matches the opening or closing line of a fenced code block.
+	fenceRegexp = regexp.MustCompile("^\\s{0,3}(```|~~~)")
+)
+
+// Release is a release in a changelog.
+type Release struct {
+	// Version is the version of the release, such as "1.2.0" or "Unreleased".
+	Version string
+	// Date is the release date, or an empty string if the heading doesn't include one.
+	Date string
+	// Body is the content of the release, including the section headings.
+	Body string
+	// Sections are the groups of changes in the release, in the order they appear.
+	Sections []Section
+}
+
+// Section is a group of changes in a release, such as the changes under `### Added`.
+type Section struct {
+	// Name is the name of the section, such as "Added".
+	Name string
+	// Body is the content of the section, without the section heading.
+	Body string
+}
+
+// IsUnreleased returns whether the release collects upcoming changes.
+func (r Release) IsUnreleased() bool {
+	return strings.EqualFold(r.Version, Unreleased)
+}
+
+// Parse returns the releases in a changelog, in the order they appear in the file.
+func Parse(content string) []Release {
+	releases := []Release{}
+
+	var release *Release
+	var releaseLines, sectionLines []string
+	var section *Section
+	var fence string
+
+	endSection := func() {
+		if section != nil {
+			section.Body = strings.TrimSpace(strings.Join(sectionLines, "\n"))
+			release.Sections = append(release.Sections, *section)
+		}
+
+		section = nil
+		sectionLines = nil
+	}
+
+	endRelease := func() {
+		if release == nil {
+			return
+		}
+
+		endSection()
+		release.Body = strings.TrimSpace(strings.Join(releaseLines, "\n"))
+		releases = append(releases, *release)
+
+		release = nil
+		releaseLines = nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if match := fenceRegexp.FindStringSubmatch(line); match != nil {
+			switch fence {
+			case "":
+				fence = match[1]
+			case match[1]:
+				fence = ""
+			}
+		}
+
+		inFence := fence != "" || fenceRegexp.MatchString(line)
+
+		if match := releaseRegexp.FindStringSubmatch(line); match != nil && !inFence {
+			endRelease()
+
+			release = &Release{Version: match[1], Date: match[2], Sections: []Section{}}
+
+			continue
+		}
+
+		if release == nil {
+			continue
+		}
+
+		if !inFence && linkReferenceRegexp.MatchString(line) {
+			continue
+		}
+
+		releaseLines = append(releaseLines, line)
+
+		if match := sectionRegexp.FindStringSubmatch(line); match != nil && !inFence {
+			endSection()
+
+			section = &Section{Name: match[1]}
+
+			continue
+		}
+
+		if section != nil {
+			sectionLines = append(sectionLines, line)
+		}
+	}
+
+	endRelease()
+
+	return releases
+}
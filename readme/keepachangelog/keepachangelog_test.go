@@ -0,0 +1,77 @@
+package keepachangelog
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	content := `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+## [Unreleased]
+
+### Added
+
+- Turtles.
+
+## [1.1.0] - 2024-02-01
+
+### Added
+
+- Tortoises.
+
+### Fixed
+
+- Shells.
+
+` + "```markdown\n## [0.0.1] - example\n### Removed\n```" + `
+
+## 1.0.0 - 2024-01-01
+
+Initial release.
+
+[Unreleased]: https://github.com/example/example/compare/v1.1.0...HEAD
+[1.1.0]: https://github.com/example/example/compare/v1.0.0...v1.1.0
+`
+
+	expected := []Release{
+		{
+			Version:  "Unreleased",
+			Body:     "### Added\n\n- Turtles.",
+			Sections: []Section{{Name: "Added", Body: "- Turtles."}},
+		},
+		{
+			Version: "1.1.0",
+			Date:    "2024-02-01",
+			Body: "### Added\n\n- Tortoises.\n\n### Fixed\n\n- Shells.\n\n" +
+				"```markdown\n## [0.0.1] - example\n### Removed\n```",
+			Sections: []Section{
+				{Name: "Added", Body: "- Tortoises."},
+				{Name: "Fixed", Body: "- Shells.\n\n```markdown\n## [0.0.1] - example\n### Removed\n```"},
+			},
+		},
+		{
+			Version:  "1.0.0",
+			Date:     "2024-01-01",
+			Body:     "Initial release.",
+			Sections: []Section{},
+		},
+	}
+
+	actual := Parse(content)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("unexpected releases:\n%#v\nexpected:\n%#v", actual, expected)
+	}
+
+	if !actual[0].IsUnreleased() || actual[1].IsUnreleased() {
+		t.Errorf("only the first release should be unreleased")
+	}
+}
+
+func TestParse_Empty(t *testing.T) {
+	if releases := Parse("# Changelog\n\nNothing has been released.\n"); len(releases) != 0 {
+		t.Errorf("expected no releases, got %#v", releases)
+	}
+}
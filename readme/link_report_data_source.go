@@ -0,0 +1,179 @@
+package readme
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &linkReportDataSource{}
+	_ datasource.DataSourceWithConfigure = &linkReportDataSource{}
+)
+
+// linkReportDataSource is the data source implementation.
+type linkReportDataSource struct {
+	client *readme.Client
+}
+
+// linkReportModel maps the link report to the Terraform data source schema.
+type linkReportModel struct {
+	BrokenLinks  []linkReportBrokenLink `tfsdk:"broken_links"`
+	DocsChecked  types.Int64            `tfsdk:"docs_checked"`
+	ID           types.String           `tfsdk:"id"`
+	LinksChecked types.Int64            `tfsdk:"links_checked"`
+	Version      types.String           `tfsdk:"version"`
+}
+
+// linkReportBrokenLink represents a broken link in a doc.
+type linkReportBrokenLink struct {
+	Doc    types.String `tfsdk:"doc"`
+	Link   types.String `tfsdk:"link"`
+	Target types.String `tfsdk:"target"`
+}
+
+// NewLinkReportDataSource is a helper function to simplify the provider implementation.
+func NewLinkReportDataSource() datasource.DataSource {
+	return &linkReportDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *linkReportDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_link_report"
+}
+
+// Schema defines the schema for the data source.
+func (d *linkReportDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Report the broken internal links in the docs of a project version on ReadMe.com\n\n" +
+			"Links to other docs using `doc:<slug>`, `ref:<slug>`, and `/docs/<slug>` are checked against the " +
+			"docs in the same version. Every doc in the version is retrieved, so this may be slow for versions " +
+			"with many docs.",
+		Attributes: map[string]schema.Attribute{
+			"broken_links": schema.ListNestedAttribute{
+				Description: "The broken links, in the order of the docs in the version.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"doc": schema.StringAttribute{
+							Description: "The slug of the doc that contains the link.",
+							Computed:    true,
+						},
+						"link": schema.StringAttribute{
+							Description: "The link destination as it's written in the doc body.",
+							Computed:    true,
+						},
+						"target": schema.StringAttribute{
+							Description: "The slug of the linked doc that doesn't exist.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"docs_checked": schema.Int64Attribute{
+				Description: "The number of docs that were checked.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The internal Terraform ID of the data source.",
+				Computed:    true,
+			},
+			"links_checked": schema.Int64Attribute{
+				Description: "The number of internal links that were checked.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The version to check. Defaults to the stable version.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *linkReportDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state linkReportModel
+
+	// Get config.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := apiRequestOptions(state.Version)
+
+	slugs, apiResponse, err := versionDocSlugs(d.client, options)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve docs.", clientError(err, apiResponse))
+
+		return
+	}
+
+	state.BrokenLinks = []linkReportBrokenLink{}
+	linksChecked := 0
+
+	for _, slug := range slugs {
+		doc, apiResponse, err := d.client.Doc.Get(slug, options)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to retrieve docs.",
+				fmt.Sprintf("There was a problem retrieving doc %s.\n%s", slug, clientError(err, apiResponse)),
+			)
+
+			return
+		}
+
+		links := internalLinks(doc.Body)
+		linksChecked += len(links)
+
+		for _, link := range brokenLinks(links, slugs) {
+			state.BrokenLinks = append(state.BrokenLinks, linkReportBrokenLink{
+				Doc:    types.StringValue(slug),
+				Link:   types.StringValue(link.Link),
+				Target: types.StringValue(link.Slug),
+			})
+		}
+	}
+
+	state.DocsChecked = types.Int64Value(int64(len(slugs)))
+	state.LinksChecked = types.Int64Value(int64(linksChecked))
+
+	// The ID attribute is only used by Terraform and the provider internally.
+	state.ID = types.StringValue("readme_link_report")
+	if state.Version.ValueString() != "" {
+		state.ID = types.StringValue("readme_link_report:" + state.Version.ValueString())
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *linkReportDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*readme.Client)
+}
@@ -0,0 +1,84 @@
+package readme
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestLinkReportDataSource(t *testing.T) {
+	// Close all gocks when completed.
+	defer gock.OffAll()
+
+	// mockLinkedDoc returns a doc with the given slug and body.
+	mockLinkedDoc := func(slug, body string) readme.Doc {
+		doc := mockDoc
+		doc.Slug = slug
+		doc.Body = body
+
+		return doc
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "readme_link_report" "test" {
+					version = "` + mockVersion.VersionClean + `"
+				}`,
+				PreConfig: func() {
+					gock.OffAll()
+					mockCategoryListOrder(0, []readme.Category{mockCategory})
+					mockCategoryDocs(mockCategory.Slug, []readme.CategoryDocs{
+						{
+							Slug:     "parent",
+							Children: []readme.CategoryDocsChildren{{Slug: "child"}},
+						},
+					})
+					gock.New(testURL).
+						Get("/docs/parent").
+						Persist().
+						Reply(200).
+						JSON(mockLinkedDoc("parent", "See [child](doc:child) and [gone](ref:gone).\n\n"+
+							"```md\n[in code](doc:ignored)\n```"))
+					gock.New(testURL).
+						Get("/docs/child").
+						Persist().
+						Reply(200).
+						JSON(mockLinkedDoc("child", "Back to the [parent](/docs/parent).\n\n[old]: doc:old-slug"))
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readme_link_report.test", "docs_checked", "2"),
+					resource.TestCheckResourceAttr("data.readme_link_report.test", "links_checked", "4"),
+					resource.TestCheckResourceAttr("data.readme_link_report.test", "broken_links.#", "2"),
+					resource.TestCheckResourceAttr("data.readme_link_report.test", "broken_links.0.doc", "parent"),
+					resource.TestCheckResourceAttr(
+						"data.readme_link_report.test",
+						"broken_links.0.link",
+						"ref:gone",
+					),
+					resource.TestCheckResourceAttr("data.readme_link_report.test", "broken_links.0.target", "gone"),
+					resource.TestCheckResourceAttr("data.readme_link_report.test", "broken_links.1.doc", "child"),
+					resource.TestCheckResourceAttr(
+						"data.readme_link_report.test",
+						"broken_links.1.target",
+						"old-slug",
+					),
+				),
+			},
+			// Test an error retrieving the docs.
+			{
+				Config: providerConfig + `data "readme_link_report" "test" {}`,
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).Get("/categories").Times(1).Reply(401).JSON(map[string]string{})
+				},
+				ExpectError: regexp.MustCompile("Unable to retrieve docs."),
+			},
+		},
+	})
+}
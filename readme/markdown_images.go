@@ -0,0 +1,259 @@
+package readme
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+var (
+	// markdownImageRegexp matches Markdown image references, such as `![alt](./img/flow.png "title")`.
+	// The second submatch is the image path.
+	markdownImageRegexp = regexp.MustCompile(`(!\[[^\]]*\]\(\s*<?)([^)\s>]+)`)
+
+	// htmlImageRegexp matches the source of HTML image tags, such as `<img src="./img/flow.png">`.
+	// The second submatch is the image path.
+	htmlImageRegexp = regexp.MustCompile(`(<img\s[^>]*?src=["'])([^"']+)`)
+)
+
+// markdownImage is a local image referenced in a Markdown body.
+type markdownImage struct {
+	// Ref is the image path as it's written in the body.
+	Ref string
+	// File is the path to the image file on disk.
+	File string
+	// SHA256 is the checksum of the image file contents.
+	SHA256 string
+}
+
+// uploadImagesSchema returns the resource schema attributes for uploading local images referenced in a body.
+// These are shared by the readme_changelog, readme_custom_page, and readme_doc resources.
+func uploadImagesSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"image_base_dir": schema.StringAttribute{
+			Description: "The directory that relative image paths in the body are resolved from when " +
+				"`upload_images` is enabled. Defaults to the directory of `source_file`, or the current working " +
+				"directory if `source_file` isn't set.",
+			Optional: true,
+		},
+		"upload_images": schema.BoolAttribute{
+			Description: "Upload local images referenced in the body to ReadMe and rewrite the references to the " +
+				"uploaded image URLs. Markdown images (`![alt](./img/flow.png)`) and HTML image tags are " +
+				"supported. Images are tracked by checksum and only uploaded when their content changes. " +
+				"Defaults to `false`.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"uploaded_images": schema.MapAttribute{
+			Description: "A map of the checksums of the uploaded local images to their URLs on ReadMe.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// isLocalImage returns true if an image reference is a path to a local file.
+func isLocalImage(ref string) bool {
+	if ref == "" || strings.HasPrefix(ref, "#") || strings.HasPrefix(ref, "//") || strings.HasPrefix(ref, "/") {
+		return false
+	}
+
+	return !strings.Contains(ref, ":")
+}
+
+// imageBaseDir returns the directory that relative image paths are resolved from.
+func imageBaseDir(baseDir, sourceFile types.String) string {
+	if baseDir.ValueString() != "" {
+		return baseDir.ValueString()
+	}
+
+	if sourceFile.ValueString() != "" {
+		return filepath.Dir(sourceFile.ValueString())
+	}
+
+	return "."
+}
+
+// findLocalImages returns the local images referenced in a body.
+// Each image is read to calculate its checksum. An image referenced more than once is only returned once.
+func findLocalImages(body, baseDir string) ([]markdownImage, error) {
+	images := []markdownImage{}
+	seen := map[string]bool{}
+
+	for _, re := range []*regexp.Regexp{markdownImageRegexp, htmlImageRegexp} {
+		for _, match := range re.FindAllStringSubmatch(body, -1) {
+			ref := match[2]
+			if !isLocalImage(ref) || seen[ref] {
+				continue
+			}
+
+			seen[ref] = true
+
+			file := filepath.Join(baseDir, filepath.FromSlash(ref))
+
+			data, err := openFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read image %s: %w", ref, err)
+			}
+
+			images = append(images, markdownImage{Ref: ref, File: file, SHA256: sha256Sum(data)})
+		}
+	}
+
+	return images, nil
+}
+
+// rewriteImages replaces references to local images in a body with the URLs of the uploaded images.
+// The urls map is keyed by the image checksums.
+func rewriteImages(body string, images []markdownImage, urls map[string]string) string {
+	refs := map[string]string{}
+	for _, image := range images {
+		refs[image.Ref] = urls[image.SHA256]
+	}
+
+	replace := func(re *regexp.Regexp) {
+		body = re.ReplaceAllStringFunc(body, func(match string) string {
+			parts := re.FindStringSubmatch(match)
+
+			url, ok := refs[parts[2]]
+			if !ok || url == "" {
+				return match
+			}
+
+			return parts[1] + url
+		})
+	}
+
+	replace(markdownImageRegexp)
+	replace(htmlImageRegexp)
+
+	return body
+}
+
+// uploadedImagesMap returns the uploaded_images attribute value from a map of checksums to URLs.
+func uploadedImagesMap(urls map[string]string) types.Map {
+	value, _ := types.MapValueFrom(context.Background(), types.StringType, urls)
+
+	return value
+}
+
+// stateImageURLs returns the URLs of the previously uploaded images from the uploaded_images attribute.
+func stateImageURLs(ctx context.Context, uploaded types.Map) map[string]string {
+	urls := map[string]string{}
+
+	if uploaded.IsNull() || uploaded.IsUnknown() {
+		return urls
+	}
+
+	uploaded.ElementsAs(ctx, &urls, false)
+
+	return urls
+}
+
+// imagesPlan holds the planned values for the local images referenced in a body.
+type imagesPlan struct {
+	// Body is the body with local image references replaced with their URLs. It's only valid when Known is true.
+	Body string
+	// Known is true when every local image has already been uploaded.
+	Known bool
+	// Uploaded is the planned value of the `uploaded_images` attribute.
+	Uploaded types.Map
+}
+
+// planImages determines whether the local images referenced in a body need to be uploaded.
+//
+// Images whose checksums are in the prior `uploaded_images` state are not uploaded again, so the body can be
+// rewritten at plan time. If any image is new or has changed, the planned body and `uploaded_images` attribute
+// are unknown until apply.
+func planImages(
+	ctx context.Context,
+	body string,
+	uploadImages types.Bool,
+	baseDir string,
+	stateUploaded types.Map,
+) (imagesPlan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !uploadImages.ValueBool() {
+		return imagesPlan{Body: body, Known: true, Uploaded: types.MapNull(types.StringType)}, diags
+	}
+
+	images, err := findLocalImages(body, baseDir)
+	if err != nil {
+		diags.AddAttributeError(path.Root("upload_images"), "Unable to read local image.", err.Error())
+
+		return imagesPlan{}, diags
+	}
+
+	prior := stateImageURLs(ctx, stateUploaded)
+	urls := map[string]string{}
+
+	for _, image := range images {
+		url, ok := prior[image.SHA256]
+		if !ok {
+			return imagesPlan{Uploaded: types.MapUnknown(types.StringType)}, diags
+		}
+
+		urls[image.SHA256] = url
+	}
+
+	return imagesPlan{Body: rewriteImages(body, images, urls), Known: true, Uploaded: uploadedImagesMap(urls)}, diags
+}
+
+// uploadImages uploads the local images referenced in a body and returns the body with the references
+// replaced with the uploaded image URLs, along with the `uploaded_images` attribute value.
+//
+// Images are deduplicated by checksum. Images that were previously uploaded, as recorded in the prior
+// `uploaded_images` state, are not uploaded again.
+func uploadImages(
+	ctx context.Context,
+	client *readme.Client,
+	body string,
+	baseDir string,
+	stateUploaded types.Map,
+) (string, types.Map, error) {
+	images, err := findLocalImages(body, baseDir)
+	if err != nil {
+		return "", types.MapNull(types.StringType), err
+	}
+
+	prior := stateImageURLs(ctx, stateUploaded)
+	urls := map[string]string{}
+
+	for _, image := range images {
+		if _, ok := urls[image.SHA256]; ok {
+			continue
+		}
+
+		if url, ok := prior[image.SHA256]; ok {
+			urls[image.SHA256] = url
+
+			continue
+		}
+
+		data, err := openFile(image.File)
+		if err != nil {
+			return "", types.MapNull(types.StringType), fmt.Errorf("unable to read image %s: %w", image.Ref, err)
+		}
+
+		uploaded, apiResponse, err := client.Image.Upload(data, image.File)
+		if err != nil {
+			return "", types.MapNull(types.StringType),
+				fmt.Errorf("unable to upload image %s: %s", image.Ref, clientError(err, apiResponse))
+		}
+
+		urls[image.SHA256] = uploaded.URL
+	}
+
+	return rewriteImages(body, images, urls), uploadedImagesMap(urls), nil
+}
@@ -0,0 +1,30 @@
+package readme
+
+import "sync"
+
+// forEachParallel calls a function with each index from zero up to a count, with at most `parallelism` calls
+// running at the same time. It returns after every call has returned.
+func forEachParallel(count, parallelism int, call func(index int)) {
+	indexes := make(chan int)
+
+	var workers sync.WaitGroup
+
+	for i := 0; i < min(max(parallelism, 1), count); i++ {
+		workers.Add(1)
+
+		go func() {
+			defer workers.Done()
+
+			for index := range indexes {
+				call(index)
+			}
+		}()
+	}
+
+	for i := 0; i < count; i++ {
+		indexes <- i
+	}
+
+	close(indexes)
+	workers.Wait()
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/terraform-provider-readme/readme/frontmatter"
 )
 
 const (
@@ -44,8 +45,17 @@ type readmeProvider struct {
 
 // readmeProviderModel maps provider schema data to a Go type.
 type readmeProviderModel struct {
-	APIToken types.String `tfsdk:"api_token"`
-	APIURL   types.String `tfsdk:"api_url"`
+	APIToken        types.String `tfsdk:"api_token"`
+	APIURL          types.String `tfsdk:"api_url"`
+	FrontMatterMode types.String `tfsdk:"front_matter_mode"`
+}
+
+// providerData is the data made available to resources from the provider configuration.
+type providerData struct {
+	// client is the ReadMe API client.
+	client *readme.Client
+	// frontMatterMode is the default front matter mode for resources that read attributes from front matter.
+	frontMatterMode string
 }
 
 // saveAction is a custom type to represent the action to take when saving a
@@ -92,6 +102,20 @@ func (p *readmeProvider) Schema(
 					"environment variable or left unset to use the default.",
 				Optional: true,
 			},
+			"front_matter_mode": schema.StringAttribute{
+				Description: "The default front matter mode for the readme_changelog, readme_custom_page, and " +
+					"readme_doc resources. In lenient mode, attributes take precedence over front matter keys and " +
+					"unsupported keys are reported as warnings. In strict mode, unsupported keys, values of the " +
+					"wrong type, and keys that are also set as attributes are reported as errors with their line " +
+					"numbers. Must be one of lenient or strict. Defaults to lenient.",
+				MarkdownDescription: "The default front matter mode for the `readme_changelog`, " +
+					"`readme_custom_page`, and `readme_doc` resources. In `lenient` mode, attributes take " +
+					"precedence over front matter keys and unsupported keys are reported as warnings. In `strict` " +
+					"mode, unsupported keys, values of the wrong type, and keys that are also set as attributes " +
+					"are reported as errors with their line numbers. Must be one of `lenient` or `strict`. " +
+					"Defaults to `lenient`.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -148,6 +172,8 @@ func (p *readmeProvider) Configure(
 		}
 	}
 
+	resp.Diagnostics.Append(validateFrontMatterMode(config.FrontMatterMode)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -181,7 +207,10 @@ func (p *readmeProvider) Configure(
 
 	// Make the Readme client available during DataSource and Resource type Configure methods.
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.ResourceData = &providerData{
+		client:          client,
+		frontMatterMode: frontMatterMode(config.FrontMatterMode, frontmatter.ModeLenient),
+	}
 
 	tflog.Info(ctx, "Configured ReadMe client", map[string]any{"success": true})
 }
@@ -196,10 +225,13 @@ func (p *readmeProvider) DataSources(_ context.Context) []func() datasource.Data
 		NewCategoryDataSource,
 		NewCategoryDocsDataSource,
 		NewChangelogDataSource,
+		NewChangelogsDataSource,
 		NewCustomPageDataSource,
 		NewCustomPagesDataSource,
 		NewDocDataSource,
 		NewDocSearchDataSource,
+		NewDocsDataSource,
+		NewLinkReportDataSource,
 		NewProjectDataSource,
 		NewVersionDataSource,
 		NewVersionsDataSource,
@@ -210,11 +242,15 @@ func (p *readmeProvider) DataSources(_ context.Context) []func() datasource.Data
 func (p *readmeProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAPISpecificationResource,
+		NewCategoryOrderResource,
 		NewCategoryResource,
 		NewChangelogResource,
+		NewChangelogFileResource,
 		NewCustomPageResource,
 		NewDocResource,
 		NewImageResource,
+		NewImagesResource,
+		NewStableVersionResource,
 		NewVersionResource,
 	}
 }
@@ -0,0 +1,125 @@
+package readme
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// searchIndexDefaultTimeout is how long to wait for a page to be indexed for search by default.
+const searchIndexDefaultTimeout = 5 * time.Minute
+
+// searchIndexPollInterval is how long to wait between checks of whether a page has been indexed for search.
+var searchIndexPollInterval = 5 * time.Second
+
+// searchIndexSchema returns the attributes for waiting for a page to be indexed for search after it's saved.
+func searchIndexSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"search_index_timeout": schema.StringAttribute{
+			Description: "How long to wait for the page to be indexed for search when `wait_for_search_index` is " +
+				"`true`, as a duration such as `30s` or `5m`. Defaults to `5m`.",
+			Optional: true,
+		},
+		"wait_for_search_index": schema.BoolAttribute{
+			Description: "Whether to wait after the page is created or updated until ReadMe has indexed it for " +
+				"search, which is when `algolia.publish_pending` is `false` and `algolia.record_count` is set. " +
+				"This allows searches that depend on the page, such as with the `readme_doc_search` data source, " +
+				"to return the latest content. A warning is shown if the page is not indexed within " +
+				"`search_index_timeout`. Defaults to `false`.",
+			Optional: true,
+		},
+	}
+}
+
+// validateSearchIndexTimeout validates the `search_index_timeout` attribute.
+func validateSearchIndexTimeout(timeout types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return diags
+	}
+
+	duration, err := time.ParseDuration(timeout.ValueString())
+	if err != nil || duration <= 0 {
+		diags.AddAttributeError(
+			path.Root("search_index_timeout"),
+			"Invalid search index timeout.",
+			fmt.Sprintf("search_index_timeout must be a positive duration such as '30s' or '5m', got '%s'.",
+				timeout.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// searchIndexed returns whether the `algolia` attribute of a page shows that it has been indexed for search.
+func searchIndexed(algolia types.Object) bool {
+	attributes := algolia.Attributes()
+	publishPending, _ := attributes["publish_pending"].(types.Bool)
+	recordCount, _ := attributes["record_count"].(types.Int64)
+
+	return !publishPending.ValueBool() && recordCount.ValueInt64() > 0
+}
+
+// waitForSearchIndex retrieves a saved page until it has been indexed for search and returns the last retrieved
+// page. The page is returned unchanged unless `wait` is true.
+//
+// A warning is returned if the page isn't indexed within the timeout, since the page itself was saved.
+func waitForSearchIndex[T any](
+	ctx context.Context,
+	wait types.Bool,
+	timeout types.String,
+	name string,
+	page T,
+	algolia func(T) types.Object,
+	get func() (T, error),
+) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !wait.ValueBool() {
+		return page, diags
+	}
+
+	// The timeout is validated by ValidateConfig.
+	duration := searchIndexDefaultTimeout
+	if !timeout.IsNull() {
+		duration, _ = time.ParseDuration(timeout.ValueString())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	for !searchIndexed(algolia(page)) {
+		select {
+		case <-ctx.Done():
+			diags.AddWarning(
+				"Search indexing did not finish.",
+				fmt.Sprintf("The %s was saved but was not indexed for search within %s. Searches may not return "+
+					"the latest content until ReadMe finishes indexing it.", name, duration),
+			)
+
+			return page, diags
+		case <-time.After(searchIndexPollInterval):
+		}
+
+		refreshed, err := get()
+		if err != nil {
+			diags.AddError(
+				"Unable to check search indexing.",
+				fmt.Sprintf("There was a problem retrieving the %s while waiting for it to be indexed for search: "+
+					"%s.", name, err),
+			)
+
+			return page, diags
+		}
+
+		page = refreshed
+	}
+
+	return page, diags
+}
@@ -0,0 +1,150 @@
+// nolint:goconst // Intentional repetition of some values for tests.
+package readme
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestWaitForSearchIndex(t *testing.T) {
+	// Check for the search index often so the tests don't wait.
+	defaultPollInterval := searchIndexPollInterval
+	searchIndexPollInterval = 10 * time.Millisecond
+	defer func() { searchIndexPollInterval = defaultPollInterval }()
+
+	pendingChangelog := mockChangelogs[0]
+	pendingChangelog.Algolia.PublishPending = true
+	indexedChangelog := mockChangelogs[0]
+	indexedChangelog.Algolia.RecordCount = 2
+
+	pendingCustomPage := mockCustomPages[0]
+	pendingCustomPage.Algolia.PublishPending = true
+	indexedCustomPage := mockCustomPages[0]
+	indexedCustomPage.Algolia.RecordCount = 2
+
+	testCases := []struct {
+		name           string
+		resource       string
+		config         string
+		gocks          func()
+		publishPending string
+		recordCount    string
+	}{
+		{
+			name:     "changelog is indexed",
+			resource: "readme_changelog",
+			config: fmt.Sprintf(`title = "%s"
+				type = "%s"
+				body = "%s"`, mockChangelogs[0].Title, mockChangelogs[0].Type, mockChangelogs[0].Body),
+			gocks: func() {
+				gock.New(testURL).Post("/changelogs").Times(1).Reply(201).JSON(pendingChangelog)
+				gock.New(testURL).Get("/changelogs/" + mockChangelogs[0].Slug).Times(2).Reply(200).JSON(pendingChangelog)
+				gock.New(testURL).Get("/changelogs/" + mockChangelogs[0].Slug).Persist().Reply(200).JSON(indexedChangelog)
+				gock.New(testURL).Delete("/changelogs/" + mockChangelogs[0].Slug).Times(1).Reply(204)
+			},
+			publishPending: "false",
+			recordCount:    "2",
+		},
+		{
+			name:     "changelog is not indexed before the timeout",
+			resource: "readme_changelog",
+			config: fmt.Sprintf(`title = "%s"
+				type = "%s"
+				body = "%s"
+				search_index_timeout = "50ms"`, mockChangelogs[0].Title, mockChangelogs[0].Type, mockChangelogs[0].Body),
+			gocks: func() {
+				gock.New(testURL).Post("/changelogs").Times(1).Reply(201).JSON(pendingChangelog)
+				gock.New(testURL).Get("/changelogs/" + mockChangelogs[0].Slug).Persist().Reply(200).JSON(pendingChangelog)
+				gock.New(testURL).Delete("/changelogs/" + mockChangelogs[0].Slug).Times(1).Reply(204)
+			},
+			publishPending: "true",
+			recordCount:    "0",
+		},
+		{
+			name:     "custom page is indexed",
+			resource: "readme_custom_page",
+			config: fmt.Sprintf(`title = "%s"
+				body = "%s"`, mockCustomPages[0].Title, mockCustomPages[0].Body),
+			gocks: func() {
+				gock.New(testURL).Post("/custompages").Times(1).Reply(201).JSON(pendingCustomPage)
+				gock.New(testURL).
+					Get("/custompages/" + mockCustomPages[0].Slug).
+					Times(2).
+					Reply(200).
+					JSON(pendingCustomPage)
+				gock.New(testURL).
+					Get("/custompages/" + mockCustomPages[0].Slug).
+					Persist().
+					Reply(200).
+					JSON(indexedCustomPage)
+				gock.New(testURL).Delete("/custompages/" + mockCustomPages[0].Slug).Times(1).Reply(204)
+			},
+			publishPending: "false",
+			recordCount:    "2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Close all gocks when completed.
+			defer gock.OffAll()
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						PreConfig: func() {
+							gock.OffAll()
+							testCase.gocks()
+						},
+						Config: providerConfig + fmt.Sprintf(`
+							resource "%s" "test" {
+								%s
+								wait_for_search_index = true
+							}`, testCase.resource, testCase.config),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								testCase.resource+".test",
+								"algolia.publish_pending",
+								testCase.publishPending,
+							),
+							resource.TestCheckResourceAttr(
+								testCase.resource+".test",
+								"algolia.record_count",
+								testCase.recordCount,
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestWaitForSearchIndex_Invalid_Timeout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					resource "readme_changelog" "test" {
+						title                 = "Test Changelog"
+						type                  = "added"
+						body                  = "This is a test changelog."
+						wait_for_search_index = true
+						search_index_timeout  = "soon"
+					}`,
+				ExpectError: regexp.MustCompile(
+					`search_index_timeout must be a positive duration such as '30s' or '5m', got\s+'soon'`,
+				),
+			},
+		},
+	})
+}
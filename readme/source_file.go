@@ -0,0 +1,244 @@
+package readme
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// bodyHashPrefix is the prefix of a body hash stored in place of the body when `store_body` is false.
+const bodyHashPrefix = "sha256:"
+
+// sourceFileSchema returns the resource schema attributes for reading a body from a local file.
+// These are shared by the readme_changelog, readme_custom_page, and readme_doc resources.
+func sourceFileSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"source_file": schema.StringAttribute{
+			Description: "Path to a local Markdown file to use as the body. The file may include front matter to " +
+				"set attributes. This is an alternative to setting `body` with the `file()` function, which stores " +
+				"the full content in the plan output. When the file changes, a summary of the changed lines is " +
+				"shown in the plan. Cannot be used with `body`.",
+			Optional: true,
+		},
+		"source_sha256": schema.StringAttribute{
+			Description: "The SHA-256 checksum of the `source_file` contents.",
+			Computed:    true,
+		},
+		"store_body": schema.BoolAttribute{
+			Description: "Whether to store the body read from `source_file` in the state. When `false`, the " +
+				"`body` attribute is not stored, the HTML output is not stored, and `body_clean` stores a " +
+				"`sha256:` checksum of the normalized body instead of its content. Changes to the remote body are " +
+				"still detected by comparing checksums. Only used with `source_file`. Defaults to `true`.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
+		},
+	}
+}
+
+// sha256Hex returns the hex-encoded SHA-256 checksum of a string.
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// bodyHash returns the value stored in `body_clean` in place of a body when `store_body` is false.
+func bodyHash(body string) string {
+	return bodyHashPrefix + sha256Hex(body)
+}
+
+// storeBodyDisabled returns true when a body is read from a source file and should not be stored in the state.
+func storeBodyDisabled(sourceFile types.String, storeBody types.Bool) bool {
+	return sourceFile.ValueString() != "" && !storeBody.IsNull() && !storeBody.IsUnknown() && !storeBody.ValueBool()
+}
+
+// readSourceFile reads a source file and returns its contents and SHA-256 checksum.
+func readSourceFile(file string) (string, string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to read source file: %w", err)
+	}
+
+	return string(content), sha256Hex(string(content)), nil
+}
+
+// configBody returns the body from the configuration for use in validation, reading it from the source file if
+// one is set. An empty string is returned if the source file isn't known yet.
+func configBody(body, sourceFile types.String) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if sourceFile.IsUnknown() || sourceFile.IsNull() {
+		return body.ValueString(), diags
+	}
+
+	if body.ValueString() != "" {
+		diags.AddAttributeError(
+			path.Root("source_file"),
+			"Conflicting attributes.",
+			"Only one of 'body' or 'source_file' may be set.",
+		)
+
+		return "", diags
+	}
+
+	content, _, err := readSourceFile(sourceFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_file"), "Unable to read source file.", err.Error())
+	}
+
+	return content, diags
+}
+
+// sourceFilePlan holds the planned values of a body read from a source file.
+type sourceFilePlan struct {
+	// Body is the planned value of the `body` attribute.
+	Body types.String
+	// Content is the body to send to the API.
+	Content string
+	// SHA256 is the planned value of the `source_sha256` attribute.
+	SHA256 types.String
+}
+
+// planSourceFile reads the source file, if set, and returns the planned body attributes.
+//
+// The `stateBody` and `stateSHA256` parameters are the values in the prior state, which are used to summarize
+// changes to the file. They are null when the resource is being created.
+//
+// When no source file is set, the planned body is returned unchanged.
+func planSourceFile(
+	plannedBody, sourceFile types.String,
+	storeBody types.Bool,
+	stateBody, stateSHA256 types.String,
+) (sourceFilePlan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if sourceFile.IsNull() {
+		return sourceFilePlan{
+			Body:    plannedBody,
+			Content: plannedBody.ValueString(),
+			SHA256:  types.StringNull(),
+		}, diags
+	}
+
+	if sourceFile.IsUnknown() {
+		return sourceFilePlan{Body: types.StringUnknown(), SHA256: types.StringUnknown()}, diags
+	}
+
+	content, sum, err := readSourceFile(sourceFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_file"), "Unable to read source file.", err.Error())
+
+		return sourceFilePlan{}, diags
+	}
+
+	planned := sourceFilePlan{
+		Body:    types.StringValue(content),
+		Content: content,
+		SHA256:  types.StringValue(sum),
+	}
+
+	if storeBodyDisabled(sourceFile, storeBody) {
+		planned.Body = types.StringNull()
+	}
+
+	if stateSHA256.ValueString() != "" && stateSHA256.ValueString() != sum {
+		diags.AddAttributeWarning(
+			path.Root("source_file"),
+			"Source file changed.",
+			sourceFileSummary(sourceFile.ValueString(), stateBody, content, stateSHA256.ValueString(), sum),
+		)
+	}
+
+	return planned, diags
+}
+
+// sourceFileBody returns the body to send to the API.
+//
+// When a source file is set, it's read again and verified against the checksum from the plan to ensure the
+// content that is applied is the content that was planned.
+func sourceFileBody(body, sourceFile, sourceSHA256 types.String) (string, error) {
+	if sourceFile.IsNull() {
+		return body.ValueString(), nil
+	}
+
+	content, sum, err := readSourceFile(sourceFile.ValueString())
+	if err != nil {
+		return "", err
+	}
+
+	if sourceSHA256.ValueString() != "" && sum != sourceSHA256.ValueString() {
+		return "", fmt.Errorf("source file %s changed after the plan was created (planned sha256 %s, got %s); "+
+			"run the plan again", sourceFile.ValueString(), sourceSHA256.ValueString(), sum)
+	}
+
+	return content, nil
+}
+
+// sourceFileSummary returns a compact summary of the changes to a source file.
+//
+// If the previous body is not stored in the state, only the change in checksum is reported.
+func sourceFileSummary(file string, previous types.String, current, previousSHA256, currentSHA256 string) string {
+	checksums := fmt.Sprintf("sha256 %s -> %s", shortSHA(previousSHA256), shortSHA(currentSHA256))
+
+	if previous.IsNull() || previous.IsUnknown() {
+		return fmt.Sprintf("%s: content changed (%s).", file, checksums)
+	}
+
+	added, removed := lineChanges(previous.ValueString(), current)
+
+	return fmt.Sprintf("%s: %d %s added, %d %s removed (%s).",
+		file, added, pluralize("line", added), removed, pluralize("line", removed), checksums)
+}
+
+// lineChanges returns the number of lines added and removed between two bodies, ignoring the position of lines.
+func lineChanges(previous, current string) (int, int) {
+	counts := map[string]int{}
+	for _, line := range strings.Split(previous, "\n") {
+		counts[line]++
+	}
+
+	added := 0
+	for _, line := range strings.Split(current, "\n") {
+		if counts[line] > 0 {
+			counts[line]--
+
+			continue
+		}
+
+		added++
+	}
+
+	removed := 0
+	for _, count := range counts {
+		removed += count
+	}
+
+	return added, removed
+}
+
+// shortSHA returns the first eight characters of a checksum.
+func shortSHA(sum string) string {
+	if len(sum) > 8 {
+		return sum[:8]
+	}
+
+	return sum
+}
+
+// pluralize returns the plural form of a word for a count.
+func pluralize(word string, count int) string {
+	if count == 1 {
+		return word
+	}
+
+	return word + "s"
+}
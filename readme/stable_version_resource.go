@@ -0,0 +1,376 @@
+package readme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &stableVersionResource{}
+	_ resource.ResourceWithConfigure   = &stableVersionResource{}
+	_ resource.ResourceWithImportState = &stableVersionResource{}
+)
+
+// stableVersionResource is the resource implementation.
+type stableVersionResource struct {
+	client *readme.Client
+}
+
+// stableVersionResourceModel maps the project's stable version to Terraform resource attributes.
+type stableVersionResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	PreviousVersion types.String   `tfsdk:"previous_version"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	Version         types.String   `tfsdk:"version"`
+	VersionClean    types.String   `tfsdk:"version_clean"`
+}
+
+// NewStableVersionResource is a helper function to simplify the provider implementation.
+func NewStableVersionResource() resource.Resource {
+	return &stableVersionResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *stableVersionResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_stable_version"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *stableVersionResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerData).client
+}
+
+// Schema defines the stable version resource attributes.
+func (r *stableVersionResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages which version is the stable version of a project on ReadMe.com\n\n" +
+			"A project has exactly one stable version. Setting `is_stable` on a `readme_version` resource " +
+			"implicitly demotes the previous stable version, which causes the other `readme_version` resource " +
+			"to drift. This resource owns the decision instead: only one `readme_stable_version` resource should " +
+			"be declared per project, and `is_stable` should be left unset on `readme_version` resources.\n\n" +
+			"Promoting a version is performed as a single ordered operation: the target version is verified to " +
+			"exist and to be neither hidden nor deprecated, it is promoted, and the project's version list is " +
+			"checked to confirm the target is the only stable version.\n\n" +
+			"Destroying this resource does not change the project's stable version. ReadMe requires a project " +
+			"to always have a stable version.\n\n" +
+			"See <https://docs.readme.com/main/reference/updateversion> for more information about this API " +
+			"endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the stable version.",
+				Computed:    true,
+			},
+			"previous_version": schema.StringAttribute{
+				Description: "The version that was stable before this resource last promoted a version. " +
+					"This is empty if the version was already stable.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "The version to set as the project's stable version. For best results, use the " +
+					"`version_clean` value of a `readme_version` resource or data source.",
+				Required: true,
+			},
+			"version_clean": schema.StringAttribute{
+				Description: "A 'clean' version string of the stable version.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ModifyPlan marks the computed attributes as unknown when the stable version changes.
+func (r *stableVersionResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var plan, state *stableVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || state == nil || plan == nil {
+		return
+	}
+
+	if !plan.Version.Equal(state.Version) {
+		plan.ID = types.StringUnknown()
+		plan.PreviousVersion = types.StringUnknown()
+		plan.VersionClean = types.StringUnknown()
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+	}
+}
+
+// Create promotes the version and sets the initial Terraform state.
+func (r *stableVersionResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan stableVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Create, operationCreate, &resp.Diagnostics)
+	defer done()
+
+	state, err := r.promote(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to set stable version.", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the project's current stable version.
+func (r *stableVersionResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state stableVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, state.Timeouts.Read, operationRead, &resp.Diagnostics)
+	defer done()
+
+	stable, apiResponse, err := r.currentStable()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read stable version.", clientError(err, apiResponse))
+
+		return
+	}
+
+	if stable == nil {
+		tflog.Info(ctx, "the project has no stable version, removing from state")
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Keep the configured version string if it still refers to the stable version. Otherwise, report the
+	// version that is currently stable so the drift is visible in the plan.
+	if state.Version.ValueString() != stable.Version && state.Version.ValueString() != stable.VersionClean {
+		state.Version = types.StringValue(stable.VersionClean)
+	}
+
+	state.ID = types.StringValue(stable.ID)
+	state.VersionClean = types.StringValue(stable.VersionClean)
+
+	if state.PreviousVersion.IsNull() || state.PreviousVersion.IsUnknown() {
+		state.PreviousVersion = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update promotes a different version and sets the updated Terraform state on success.
+func (r *stableVersionResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan stableVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Update, operationUpdate, &resp.Diagnostics)
+	defer done()
+
+	state, err := r.promote(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to set stable version.", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete removes the resource from the Terraform state.
+//
+// The project's stable version is not changed because ReadMe requires a project to have a stable version.
+func (r *stableVersionResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state stableVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Stable version was not changed.",
+		fmt.Sprintf("The resource was removed from the Terraform state but version %s remains the project's "+
+			"stable version on ReadMe. A project must always have a stable version.",
+			state.VersionClean.ValueString()),
+	)
+}
+
+// ImportState imports the stable version by its version string.
+func (r *stableVersionResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("version"), req, resp)
+}
+
+// currentStable returns the project's current stable version from the list of all versions.
+// A nil version is returned if no version is stable.
+func (r *stableVersionResource) currentStable() (*readme.VersionSummary, *readme.APIResponse, error) {
+	versions, apiResponse, err := r.client.Version.GetAll()
+	if err != nil {
+		return nil, apiResponse, err
+	}
+
+	for _, vers := range versions {
+		if vers.IsStable {
+			return &vers, apiResponse, nil
+		}
+	}
+
+	return nil, apiResponse, nil
+}
+
+// promote sets the planned version as the project's stable version and returns the resulting state.
+//
+// The operation is performed in order so that a failure at any step leaves the project unchanged or
+// reports exactly which step failed:
+//
+//  1. The current stable version is recorded.
+//  2. The target version is retrieved and verified to be neither hidden nor deprecated.
+//  3. The target version is updated with `is_stable` set, preserving its other attributes.
+//  4. The list of versions is retrieved to confirm the target is now the only stable version.
+func (r *stableVersionResource) promote(
+	ctx context.Context,
+	plan stableVersionResourceModel,
+) (stableVersionResourceModel, error) {
+	previous, apiResponse, err := r.currentStable()
+	if err != nil {
+		return plan, fmt.Errorf("unable to retrieve the current stable version: %s", clientError(err, apiResponse))
+	}
+
+	target, apiResponse, err := r.client.Version.Get(plan.Version.ValueString())
+	if err != nil {
+		return plan, fmt.Errorf("unable to retrieve version %s: %s",
+			plan.Version.ValueString(), clientError(err, apiResponse))
+	}
+
+	if target.IsHidden {
+		return plan, fmt.Errorf("version %s is hidden and cannot be the stable version", target.VersionClean)
+	}
+
+	if target.IsDeprecated {
+		return plan, fmt.Errorf("version %s is deprecated and cannot be the stable version", target.VersionClean)
+	}
+
+	plan.PreviousVersion = types.StringValue("")
+
+	if target.IsStable {
+		tflog.Info(ctx, fmt.Sprintf("version %s is already the stable version", target.VersionClean))
+	} else {
+		if previous != nil {
+			plan.PreviousVersion = types.StringValue(previous.VersionClean)
+		}
+
+		from := r.forkedFromVersion(ctx, target)
+
+		tflog.Info(ctx, fmt.Sprintf("promoting version %s to stable", target.VersionClean))
+
+		_, apiResponse, err = r.client.Version.Update(target.VersionClean, readme.VersionParams{
+			Codename:     target.Codename,
+			From:         from,
+			IsBeta:       boolPoint(target.IsBeta),
+			IsDeprecated: boolPoint(target.IsDeprecated),
+			IsHidden:     boolPoint(target.IsHidden),
+			IsStable:     boolPoint(true),
+			Version:      target.Version,
+		})
+		if err != nil {
+			return plan, fmt.Errorf("unable to promote version %s: %s",
+				target.VersionClean, clientError(err, apiResponse))
+		}
+	}
+
+	// Verify the promotion.
+	stable, apiResponse, err := r.currentStable()
+	if err != nil {
+		return plan, fmt.Errorf("unable to verify the stable version: %s", clientError(err, apiResponse))
+	}
+
+	if stable == nil || stable.ID != target.ID {
+		return plan, errors.New("the stable version did not change after promotion; " +
+			"the version may have been modified outside of Terraform")
+	}
+
+	plan.ID = types.StringValue(stable.ID)
+	plan.VersionClean = types.StringValue(stable.VersionClean)
+
+	return plan, nil
+}
+
+// forkedFromVersion returns the version string a version was forked from, which is required by the API when
+// updating a version. If the version was not forked from another version or the source version no longer exists,
+// its own version string is returned.
+func (r *stableVersionResource) forkedFromVersion(ctx context.Context, version readme.Version) string {
+	if version.ForkedFrom == "" {
+		return version.Version
+	}
+
+	from, err := r.client.Version.GetVersion(IDPrefix + version.ForkedFrom)
+	if err != nil || from == "" {
+		tflog.Info(ctx, fmt.Sprintf("unable to resolve the version %s was forked from, using itself: %v",
+			version.VersionClean, err))
+
+		return version.Version
+	}
+
+	return from
+}
@@ -0,0 +1,191 @@
+// nolint:goconst // Intentional repetition of some values for tests.
+package readme
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestStableVersionResource(t *testing.T) {
+	// mockPromoted is the version that gets promoted to stable.
+	mockPromoted := mockVersion
+	mockPromoted.ID = "638cf4cfdea3ff0096d1a95b"
+	mockPromoted.IsStable = false
+	mockPromoted.Version = "1.2.0"
+	mockPromoted.VersionClean = "1.2.0"
+
+	// mockBeforeList and mockAfterList are the lists of versions before and after promoting mockPromoted.
+	mockBeforeList := []readme.VersionSummary{
+		mockVersionList[0],
+		{
+			ID:           mockPromoted.ID,
+			IsStable:     false,
+			Version:      mockPromoted.Version,
+			VersionClean: mockPromoted.VersionClean,
+		},
+	}
+	mockAfterList := []readme.VersionSummary{
+		mockVersionList[0],
+		mockBeforeList[1],
+	}
+	mockAfterList[0].IsStable = false
+	mockAfterList[1].IsStable = true
+
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test managing a version that is already stable.
+			{
+				Config: providerConfig + `resource "readme_stable_version" "test" {
+					version = "` + mockVersion.VersionClean + `"
+				}`,
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Get(versionEndpoint + "/" + mockVersion.VersionClean).
+						Times(1).
+						Reply(200).
+						JSON(mockVersion)
+					gock.New(testURL).
+						Get(versionEndpoint + "$").
+						Persist().
+						Reply(200).
+						JSON(mockVersionList)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_stable_version.test", "id", mockVersion.ID),
+					resource.TestCheckResourceAttr(
+						"readme_stable_version.test",
+						"version_clean",
+						mockVersion.VersionClean,
+					),
+					resource.TestCheckResourceAttr("readme_stable_version.test", "previous_version", ""),
+				),
+			},
+			// Test promoting a different version.
+			{
+				Config: providerConfig + `resource "readme_stable_version" "test" {
+					version = "` + mockPromoted.VersionClean + `"
+				}`,
+				PreConfig: func() {
+					gock.OffAll()
+					// Refresh and look up the current stable version before promotion.
+					gock.New(testURL).
+						Get(versionEndpoint + "$").
+						Times(2).
+						Reply(200).
+						JSON(mockBeforeList)
+					gock.New(testURL).
+						Get(versionEndpoint + "/" + mockPromoted.VersionClean).
+						Times(1).
+						Reply(200).
+						JSON(mockPromoted)
+					// Resolve the 'from' version.
+					gock.New(testURL).
+						Get(versionEndpoint + "$").
+						Times(1).
+						Reply(200).
+						JSON(mockBeforeList)
+					gock.New(testURL).
+						Put(versionEndpoint + "/" + mockPromoted.VersionClean).
+						Times(1).
+						Reply(200).
+						JSON(mockPromoted)
+					// Verify and refresh after promotion.
+					gock.New(testURL).
+						Get(versionEndpoint + "$").
+						Persist().
+						Reply(200).
+						JSON(mockAfterList)
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_stable_version.test", "id", mockPromoted.ID),
+					resource.TestCheckResourceAttr(
+						"readme_stable_version.test",
+						"version_clean",
+						mockPromoted.VersionClean,
+					),
+					resource.TestCheckResourceAttr(
+						"readme_stable_version.test",
+						"previous_version",
+						mockVersion.VersionClean,
+					),
+				),
+			},
+			// Test importing.
+			{
+				ResourceName:            "readme_stable_version.test",
+				ImportState:             true,
+				ImportStateId:           mockPromoted.VersionClean,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"previous_version"},
+			},
+		},
+	})
+}
+
+func TestStableVersionResource_Error(t *testing.T) {
+	mockHidden := mockVersion
+	mockHidden.IsStable = false
+	mockHidden.IsHidden = true
+	mockHidden.Version = "1.3.0"
+	mockHidden.VersionClean = "1.3.0"
+
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A hidden version cannot be promoted.
+			{
+				Config: providerConfig + `resource "readme_stable_version" "test" {
+					version = "` + mockHidden.VersionClean + `"
+				}`,
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Get(versionEndpoint + "$").
+						Times(1).
+						Reply(200).
+						JSON(mockVersionList)
+					gock.New(testURL).
+						Get(versionEndpoint + "/" + mockHidden.VersionClean).
+						Times(1).
+						Reply(200).
+						JSON(mockHidden)
+				},
+				ExpectError: regexp.MustCompile("is hidden and cannot be the stable version"),
+			},
+			// An API error when retrieving the version.
+			{
+				Config: providerConfig + `resource "readme_stable_version" "test" {
+					version = "9.9.9"
+				}`,
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Get(versionEndpoint + "$").
+						Times(1).
+						Reply(200).
+						JSON(mockVersionList)
+					gock.New(testURL).
+						Get(versionEndpoint + "/9.9.9").
+						Times(1).
+						Reply(404).
+						JSON(mockAPIError)
+				},
+				ExpectError: regexp.MustCompile("Unable to set stable version"),
+			},
+		},
+	})
+}
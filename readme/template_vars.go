@@ -0,0 +1,82 @@
+package readme
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/liveoaklabs/terraform-provider-readme/readme/frontmatter"
+)
+
+// templateVarsSchema returns the resource schema attribute for rendering a body as a template.
+// This is shared by the readme_changelog, readme_custom_page, and readme_doc resources.
+func templateVarsSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"template_vars": schema.MapAttribute{
+			Description: "Variables for rendering the body as a Go template. When set, the body is rendered " +
+				"before the front matter is parsed, so front matter values may also reference variables. " +
+				"Variables are referenced as `{{ .name }}` and a reference to an undefined variable fails the " +
+				"plan. The `lower`, `upper`, `trim`, `trimPrefix`, `trimSuffix`, `replace`, `contains`, " +
+				"`hasPrefix`, `hasSuffix`, and `default` functions are available. The `body_clean` attribute " +
+				"reflects the rendered body.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// templateVars returns the variables for rendering a body and whether they're known.
+// A nil map is returned if the variables aren't set, which disables rendering.
+func templateVars(ctx context.Context, vars types.Map) (map[string]string, bool) {
+	if vars.IsUnknown() {
+		return nil, false
+	}
+
+	if vars.IsNull() {
+		return nil, true
+	}
+
+	values := map[string]string{}
+	vars.ElementsAs(ctx, &values, false)
+
+	return values, true
+}
+
+// renderBody renders a body as a template with the variables from the `template_vars` attribute.
+func renderBody(ctx context.Context, body string, vars types.Map) (string, error) {
+	values, _ := templateVars(ctx, vars)
+
+	return frontmatter.Render(body, values)
+}
+
+// planTemplate renders a body during the plan. Errors are reported on the `source_file` attribute if it's set,
+// or the `body` attribute otherwise.
+//
+// The returned bool is false if the variables aren't known yet, in which case the body is returned unrendered.
+func planTemplate(
+	ctx context.Context,
+	body string,
+	vars types.Map,
+	sourceFile types.String,
+) (string, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values, known := templateVars(ctx, vars)
+	if !known {
+		return body, false, diags
+	}
+
+	rendered, err := frontmatter.Render(body, values)
+	if err != nil {
+		attribute := path.Root("body")
+		if sourceFile.ValueString() != "" {
+			attribute = path.Root("source_file")
+		}
+
+		diags.AddAttributeError(attribute, "Unable to render template.", err.Error())
+	}
+
+	return rendered, true, diags
+}
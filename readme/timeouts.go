@@ -0,0 +1,111 @@
+package readme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// defaultOperationTimeout is how long a resource operation may take when its timeout isn't configured.
+const defaultOperationTimeout = 20 * time.Minute
+
+// Timeout operations, used to name the operation that timed out.
+const (
+	operationCreate = "create"
+	operationRead   = "read"
+	operationUpdate = "update"
+	operationDelete = "delete"
+)
+
+// timeoutsBlock returns the `timeouts` block for configuring how long each operation on a resource may take.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// timeoutsAttributeTypes returns the attribute types of the `timeouts` block for data sources that share a model
+// with a resource.
+func timeoutsAttributeTypes() map[string]attr.Type {
+	return timeoutsBlock(context.Background()).Type().(timeouts.Type).AttrTypes
+}
+
+// withTimeout limits an operation on a resource to its configured timeout and returns the context of the operation
+// along with a function that must be deferred to end it.
+//
+// The client is replaced with a copy that cancels its requests when the operation times out, which the returned
+// function restores. If the operation failed because it timed out, the returned function adds an error that names
+// the operation.
+func withTimeout(
+	ctx context.Context,
+	client **readme.Client,
+	timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics),
+	operation string,
+	diags *diag.Diagnostics,
+) (context.Context, func()) {
+	duration, timeoutDiags := timeout(ctx, defaultOperationTimeout)
+	diags.Append(timeoutDiags...)
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	original := *client
+	*client = clientWithContext(ctx, original)
+
+	return ctx, func() {
+		timedOut := errors.Is(ctx.Err(), context.DeadlineExceeded)
+
+		cancel()
+		*client = original
+
+		if timedOut && diags.HasError() {
+			diags.AddError(
+				"Operation timed out.",
+				fmt.Sprintf("The %s operation did not finish within %s. Increase the %s timeout in the "+
+					"resource's timeouts block if the operation needs more time.", operation, duration, operation),
+			)
+		}
+	}
+}
+
+// contextTransport is an HTTP transport that binds each request to a context.
+type contextTransport struct {
+	ctx  context.Context //nolint:containedctx // The client API doesn't accept a context per request.
+	base http.RoundTripper
+}
+
+// RoundTrip performs the request with the transport's context.
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(req.WithContext(t.ctx)) //nolint:wrapcheck // The error is returned by the HTTP client.
+}
+
+// clientWithContext returns a copy of a client whose requests are canceled when the context is done.
+func clientWithContext(ctx context.Context, client *readme.Client) *readme.Client {
+	copied, err := readme.NewClient(client.Token, client.APIURL)
+	if err != nil {
+		return client
+	}
+
+	copied.HTTPClient = &http.Client{
+		CheckRedirect: client.HTTPClient.CheckRedirect,
+		Jar:           client.HTTPClient.Jar,
+		Timeout:       client.HTTPClient.Timeout,
+		Transport:     &contextTransport{ctx: ctx, base: client.HTTPClient.Transport},
+	}
+
+	return copied
+}
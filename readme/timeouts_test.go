@@ -0,0 +1,85 @@
+package readme
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestResourceTimeouts(t *testing.T) {
+	// Close all gocks when completed.
+	defer gock.OffAll()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that an operation that finishes within its timeout succeeds.
+			{
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).Post("/changelogs").Times(1).Reply(201).JSON(mockChangelogs[0])
+					gock.New(testURL).
+						Get("/changelogs/" + mockChangelogs[0].Slug).
+						Persist().
+						Reply(200).
+						JSON(mockChangelogs[0])
+					gock.New(testURL).Delete("/changelogs/" + mockChangelogs[0].Slug).Times(1).Reply(204)
+				},
+				Config: providerConfig + `
+					resource "readme_changelog" "test" {
+						title = "` + mockChangelogs[0].Title + `"
+						type  = "` + mockChangelogs[0].Type + `"
+						body  = "` + mockChangelogs[0].Body + `"
+
+						timeouts {
+							create = "1m"
+							read   = "1m"
+							delete = "1m"
+						}
+					}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("readme_changelog.test", "timeouts.create", "1m"),
+					resource.TestCheckResourceAttr("readme_changelog.test", "id", mockChangelogs[0].ID),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceTimeouts_Exceeded(t *testing.T) {
+	// Close all gocks when completed.
+	defer gock.OffAll()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Post("/changelogs").
+						Times(1).
+						Reply(201).
+						Delay(5 * time.Second).
+						JSON(mockChangelogs[0])
+				},
+				Config: providerConfig + `
+					resource "readme_changelog" "test" {
+						title = "` + mockChangelogs[0].Title + `"
+						type  = "` + mockChangelogs[0].Type + `"
+						body  = "` + mockChangelogs[0].Body + `"
+
+						timeouts {
+							create = "100ms"
+						}
+					}`,
+				ExpectError: regexp.MustCompile(`The create operation did not finish within 100ms`),
+			},
+		},
+	})
+}
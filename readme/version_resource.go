@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -32,20 +35,22 @@ type versionResource struct {
 
 // versionResourceModel maps the struct from the ReadMe client library to Terraform resource attributes.
 type versionResourceModel struct {
-	Categories   types.List   `tfsdk:"categories"`
-	Codename     types.String `tfsdk:"codename"`
-	CreatedAt    types.String `tfsdk:"created_at"`
-	From         types.String `tfsdk:"from"`
-	ForkedFrom   types.String `tfsdk:"forked_from"`
-	ID           types.String `tfsdk:"id"`
-	IsBeta       types.Bool   `tfsdk:"is_beta"`
-	IsDeprecated types.Bool   `tfsdk:"is_deprecated"`
-	IsHidden     types.Bool   `tfsdk:"is_hidden"`
-	IsStable     types.Bool   `tfsdk:"is_stable"`
-	Project      types.String `tfsdk:"project"`
-	ReleaseDate  types.String `tfsdk:"release_date"`
-	Version      types.String `tfsdk:"version"`
-	VersionClean types.String `tfsdk:"version_clean"`
+	Categories   types.List     `tfsdk:"categories"`
+	Codename     types.String   `tfsdk:"codename"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	ForceDestroy types.Bool     `tfsdk:"force_destroy"`
+	From         types.String   `tfsdk:"from"`
+	ForkedFrom   types.String   `tfsdk:"forked_from"`
+	ID           types.String   `tfsdk:"id"`
+	IsBeta       types.Bool     `tfsdk:"is_beta"`
+	IsDeprecated types.Bool     `tfsdk:"is_deprecated"`
+	IsHidden     types.Bool     `tfsdk:"is_hidden"`
+	IsStable     types.Bool     `tfsdk:"is_stable"`
+	Project      types.String   `tfsdk:"project"`
+	ReleaseDate  types.String   `tfsdk:"release_date"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+	Version      types.String   `tfsdk:"version"`
+	VersionClean types.String   `tfsdk:"version_clean"`
 }
 
 // NewVersionResource is a helper function to simplify the provider implementation.
@@ -72,7 +77,7 @@ func (r *versionResource) Configure(
 		return
 	}
 
-	r.client = req.ProviderData.(*readme.Client)
+	r.client = req.ProviderData.(*providerData).client
 }
 
 // ValidateConfig is used for validating attribute values.
@@ -106,7 +111,7 @@ func (r versionResource) ValidateConfig(
 
 // Schema defines the version resource attributes.
 func (r *versionResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -138,6 +143,15 @@ func (r *versionResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "Allow the version to be destroyed while its categories still contain docs. When false, " +
+					"destroying a version that contains docs fails and reports the remaining docs per category. " +
+					"Deleting a version on ReadMe deletes all of its categories and docs. " +
+					"The project's stable version can never be destroyed, regardless of this setting.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"from": schema.StringAttribute{
 				Description: "The version this version is derived from. Note that this is only an attribute used for " +
 					"initial creation. The ReadMe API otherwise refers to the 'from' value as an ID tracked in the " +
@@ -190,7 +204,10 @@ func (r *versionResource) Schema(
 			"is_stable": schema.BoolAttribute{
 				Description: "Toggles if the version is stable. A project can only have a single stable version. " +
 					"Changing a stable version to non-stable will trigger a replacement. " +
-					"The main 'stable' version for a project cannot be deleted.",
+					"The main 'stable' version for a project cannot be deleted. " +
+					"When the project's stable version is managed with the `readme_stable_version` resource, leave " +
+					"this attribute unset. Unset values are tracked from the API and changes made by " +
+					"`readme_stable_version` will not show as drift.",
 				Computed: true,
 				Optional: true,
 				PlanModifiers: []planmodifier.Bool{
@@ -234,6 +251,9 @@ func (r *versionResource) Schema(
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -254,6 +274,17 @@ func (r *versionResource) ModifyPlan(
 	if !plan.Version.Equal(state.Version) {
 		plan.VersionClean = types.StringUnknown()
 	}
+
+	// When 'is_stable' isn't configured, the stable version is managed elsewhere, such as with the
+	// readme_stable_version resource. Its value may change during the same apply, so it's left unknown
+	// rather than planning the prior state value whenever the version is updated.
+	var isStableConfig types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_stable"), &isStableConfig)...)
+	if isStableConfig.IsNull() && !req.Plan.Raw.Equal(req.State.Raw) {
+		plan.IsStable = types.BoolUnknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
 // Create a version and set the initial Terraform state.
@@ -270,6 +301,9 @@ func (r *versionResource) Create(
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Create, operationCreate, &resp.Diagnostics)
+	defer done()
+
 	// Create the version.
 	plan, err := r.save("create", plan)
 	if err != nil {
@@ -300,10 +334,13 @@ func (r *versionResource) Read(
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Read, operationRead, &resp.Diagnostics)
+	defer done()
+
 	// Get version metadata.
 	state, apiResponse, err := r.get(plan.VersionClean.ValueString(), plan)
 	if err != nil {
-		if apiResponse.APIErrorResponse.Error == "VERSION_NOTFOUND" {
+		if apiResponse != nil && apiResponse.APIErrorResponse.Error == "VERSION_NOTFOUND" {
 			resp.State.RemoveResource(ctx)
 
 			return
@@ -335,6 +372,17 @@ func (r *versionResource) Update(
 		return
 	}
 
+	ctx, done := withTimeout(ctx, &r.client, plan.Timeouts.Update, operationUpdate, &resp.Diagnostics)
+	defer done()
+
+	// Don't send 'is_stable' unless it's configured. The stable version may have been changed by the
+	// readme_stable_version resource during the same apply and sending the prior value would revert it.
+	var isStableConfig types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_stable"), &isStableConfig)...)
+	if isStableConfig.IsNull() {
+		plan.IsStable = types.BoolNull()
+	}
+
 	// Update the version.
 	plan, err := r.save("update", plan, state.VersionClean.ValueString())
 	if err != nil {
@@ -363,6 +411,15 @@ func (r *versionResource) Delete(
 		return
 	}
 
+	_, done := withTimeout(ctx, &r.client, state.Timeouts.Delete, operationDelete, &resp.Diagnostics)
+	defer done()
+
+	// Check the version's current remote state before deleting it.
+	resp.Diagnostics.Append(r.deletable(state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete the version.
 	_, apiResponse, err := r.client.Version.Delete(state.VersionClean.ValueString())
 	if err != nil {
@@ -412,6 +469,7 @@ func (r *versionResource) get(
 		Codename:     types.StringValue(response.Codename),
 		CreatedAt:    types.StringValue(response.CreatedAt),
 		ID:           types.StringValue(response.ID),
+		ForceDestroy: plan.ForceDestroy,
 		ForkedFrom:   types.StringValue(response.ForkedFrom),
 		From:         plan.From,
 		IsBeta:       types.BoolValue(response.IsBeta),
@@ -420,6 +478,7 @@ func (r *versionResource) get(
 		IsStable:     types.BoolValue(response.IsStable),
 		Project:      types.StringValue(response.Project),
 		ReleaseDate:  types.StringValue(response.ReleaseDate),
+		Timeouts:     plan.Timeouts,
 		Version:      types.StringValue(response.Version),
 		VersionClean: types.StringValue(response.VersionClean),
 	}
@@ -432,9 +491,79 @@ func (r *versionResource) get(
 
 	state.Categories, _ = types.ListValue(types.StringType, categories)
 
+	// force_destroy is not tracked by the API.
+	if state.ForceDestroy.IsNull() || state.ForceDestroy.IsUnknown() {
+		state.ForceDestroy = types.BoolValue(false)
+	}
+
 	return state, apiResponse, nil
 }
 
+// deletable checks whether a version can be deleted.
+//
+// The project's stable version can never be deleted. Unless force_destroy is enabled, a version can only be
+// deleted when none of its categories contain docs.
+func (r *versionResource) deletable(state versionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	title := fmt.Sprintf("Unable to delete version %s.", state.VersionClean)
+
+	response, apiResponse, err := r.client.Version.Get(state.VersionClean.ValueString())
+	if err != nil {
+		diags.AddError(title, "There was a problem retrieving the version.\n"+clientError(err, apiResponse))
+
+		return diags
+	}
+
+	if response.IsStable {
+		diags.AddError(title, fmt.Sprintf("Version %s is the project's stable version. ReadMe requires a "+
+			"project to always have a stable version. Promote a different version to stable, for example with "+
+			"the readme_stable_version resource, before destroying this version.", response.VersionClean))
+
+		return diags
+	}
+
+	if state.ForceDestroy.ValueBool() {
+		return diags
+	}
+
+	options := readme.RequestOptions{Version: response.VersionClean}
+
+	categories, apiResponse, err := r.client.Category.GetAll(options)
+	if err != nil {
+		diags.AddError(title, "There was a problem retrieving the version's categories.\n"+
+			clientError(err, apiResponse))
+
+		return diags
+	}
+
+	total := 0
+	details := ""
+
+	for _, category := range categories {
+		docs, apiResponse, err := categoryDocSlugs(r.client, category.Slug, options)
+		if err != nil {
+			diags.AddError(title, fmt.Sprintf("There was a problem retrieving the docs in category %s.\n%s",
+				category.Slug, clientError(err, apiResponse)))
+
+			return diags
+		}
+
+		if len(docs) > 0 {
+			total += len(docs)
+			details += fmt.Sprintf("\n  - %s: %s", category.Slug, summarizeSlugs(docs))
+		}
+	}
+
+	if total > 0 {
+		diags.AddError(title, fmt.Sprintf("The version contains %d doc(s):%s\n\n", total, details)+
+			"Deleting the version would delete these docs. Move or delete the docs first, or set "+
+			"force_destroy = true on the version to delete it anyway.")
+	}
+
+	return diags
+}
+
 // save is a helper function to create or update a version.
 // The version is returned as a versionResourceModel.
 // A string is returned in the second position for an error message that the caller function references in its
@@ -179,12 +179,14 @@ func TestVersionResource(t *testing.T) {
 				PreConfig: func() {
 					mockUpdatedVersion.IsStable = false
 					gock.OffAll()
-					// Expect the provider to read the updated version twice.
+					// Expect the provider to read the updated version, including the pre-delete checks.
 					gock.New(testURL).
 						Get(versionEndpoint + "/" + mockUpdatedVersion.Version).
-						Times(2).
+						Persist().
 						Reply(200).
 						JSON(mockUpdatedVersion)
+					// Pre-delete check for docs in the version's categories.
+					mockVersionCategoryDocs([]readme.CategoryDocs{})
 					// Expect the replacement to POST a new version.
 					gock.New(testURL).
 						Post(versionEndpoint).
@@ -210,6 +212,117 @@ func TestVersionResource(t *testing.T) {
 	})
 }
 
+// mockVersionCategoryDocs mocks the requests for the categories in a version and the docs in each category.
+func mockVersionCategoryDocs(docs []readme.CategoryDocs) {
+	mockCategoryDocs(mockCategory.Slug, docs)
+	gock.New(testURL).
+		Get("/categories").
+		MatchParam("page", "1").
+		Persist().
+		Reply(200).
+		AddHeader("link", `<>; rel="next", <>; rel="prev", <>; rel="last"`).
+		JSON([]readme.Category{mockCategory})
+}
+
+// TestVersionResource_Delete tests that a stable version or a version containing docs is not deleted unless
+// force_destroy is enabled.
+func TestVersionResource_Delete(t *testing.T) {
+	mockUnstable := mockVersion
+	mockUnstable.IsStable = false
+
+	mockStable := mockVersion
+	mockStable.IsStable = true
+
+	mockDocs := []readme.CategoryDocs{{ID: "6398a4a594b26e00885e7ec0", Slug: "some-doc", Title: "Some Doc"}}
+
+	config := providerConfig + `resource "readme_version" "test" {
+		from    = "1.0.0"
+		version = "` + mockUnstable.Version + `"
+	}`
+
+	// Close all gocks after completion.
+	defer gock.OffAll()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a version.
+			{
+				Config: config,
+				PreConfig: func() {
+					gock.New(testURL).
+						Get(versionEndpoint + "/" + mockUnstable.Version).
+						Persist().
+						Reply(200).
+						JSON(mockUnstable)
+					gock.New(testURL).
+						Post(versionEndpoint).
+						Times(1).
+						Reply(200).
+						JSON(mockUnstable)
+				},
+				Check: resource.TestCheckResourceAttr("readme_version.test", "force_destroy", "false"),
+			},
+			// A version containing docs is not deleted.
+			{
+				Config:      config,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`The version contains 1 doc\(s\)`),
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Get(versionEndpoint + "/" + mockUnstable.Version).
+						Persist().
+						Reply(200).
+						JSON(mockUnstable)
+					mockVersionCategoryDocs(mockDocs)
+				},
+			},
+			// The stable version is never deleted.
+			{
+				Config:      config,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("is the project's stable version"),
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Get(versionEndpoint + "/" + mockStable.Version).
+						Persist().
+						Reply(200).
+						JSON(mockStable)
+				},
+			},
+			// A version containing docs is deleted when force_destroy is enabled.
+			{
+				Config: providerConfig + `resource "readme_version" "test" {
+					from          = "1.0.0"
+					version       = "` + mockUnstable.Version + `"
+					force_destroy = true
+				}`,
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Get(versionEndpoint + "/" + mockUnstable.Version).
+						Persist().
+						Reply(200).
+						JSON(mockUnstable)
+					gock.New(testURL).
+						Put(versionEndpoint + "/" + mockUnstable.Version).
+						Times(1).
+						Reply(200).
+						JSON(mockUnstable)
+					gock.New(testURL).
+						Delete(versionEndpoint + "/" + mockUnstable.Version).
+						Times(1).
+						Reply(200)
+				},
+				Check: resource.TestCheckResourceAttr("readme_version.test", "force_destroy", "true"),
+			},
+		},
+	})
+}
+
 func TestVersionsResource_Error(t *testing.T) {
 	// expectCreateResponse is what is expected upon creation.
 	expectCreateResponse := readme.APIErrorResponse{
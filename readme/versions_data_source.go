@@ -2,9 +2,14 @@ package readme
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/liveoaklabs/readme-api-go-client/readme"
 )
@@ -22,8 +27,15 @@ type versionsDataSource struct {
 
 // versionsList maps a ReadMe Version to the Terraform schema.
 type versionsList struct {
-	ID       types.String     `tfsdk:"id"`
-	Versions []versionSummary `tfsdk:"versions"`
+	Constraint        types.String     `tfsdk:"constraint"`
+	ID                types.String     `tfsdk:"id"`
+	IncludeBeta       types.Bool       `tfsdk:"include_beta"`
+	IncludeDeprecated types.Bool       `tfsdk:"include_deprecated"`
+	IncludeHidden     types.Bool       `tfsdk:"include_hidden"`
+	InvalidVersions   []types.String   `tfsdk:"invalid_versions"`
+	Latest            *versionSummary  `tfsdk:"latest"`
+	SortBySemver      types.Bool       `tfsdk:"sort_by_semver"`
+	Versions          []versionSummary `tfsdk:"versions"`
 }
 
 // versionSummary maps a version in the list of all versions to the Terraform schema.
@@ -82,13 +94,49 @@ func (d *versionsDataSource) Schema(
 		Description: "Retrieve a list of versions with metadata from ReadMe.\n\n" +
 			"See <https://docs.readme.com/main/reference/getversions> for more information about this API endpoint.",
 		Attributes: map[string]schema.Attribute{
+			"constraint": schema.StringAttribute{
+				Description: "A semantic version constraint to filter versions by, for example `>= 2.0, < 3`. " +
+					"Versions that are not valid semantic versions never match a constraint and are listed in " +
+					"`invalid_versions`.",
+				Optional: true,
+			},
 			"id": schema.StringAttribute{
 				Description: "Internally used identifier attribute. This attribute only exists within the provider " +
 					"not the API.",
 				Computed: true,
 			},
+			"include_beta": schema.BoolAttribute{
+				Description: "Include beta versions. Defaults to `true`.",
+				Optional:    true,
+			},
+			"include_deprecated": schema.BoolAttribute{
+				Description: "Include deprecated versions. Defaults to `true`.",
+				Optional:    true,
+			},
+			"include_hidden": schema.BoolAttribute{
+				Description: "Include hidden versions. Defaults to `true`.",
+				Optional:    true,
+			},
+			"invalid_versions": schema.ListAttribute{
+				Description: "List of version strings that are not valid semantic versions. These versions are " +
+					"excluded when a `constraint` is set, sorted last when `sort_by_semver` is set, and never " +
+					"considered for `latest`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"latest": schema.SingleNestedAttribute{
+				Description: "The highest semantic version that matches the filters. This is null if no valid " +
+					"semantic version matches.",
+				Computed:   true,
+				Attributes: versionSummarySchema(),
+			},
+			"sort_by_semver": schema.BoolAttribute{
+				Description: "Sort the list of versions in ascending semantic version order instead of the order " +
+					"returned by the API. Versions that are not valid semantic versions are sorted last in API order.",
+				Optional: true,
+			},
 			"versions": schema.ListNestedAttribute{
-				Description: "The list of versions on ReadMe.com.",
+				Description: "The list of versions on ReadMe.com matching the filters.",
 				Computed:    true,
 				Optional:    false,
 				NestedObject: schema.NestedAttributeObject{
@@ -114,6 +162,21 @@ func (d *versionsDataSource) Read(
 		return
 	}
 
+	var constraint version.Constraints
+	if state.Constraint.ValueString() != "" {
+		var err error
+		constraint, err = version.NewConstraint(state.Constraint.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("constraint"),
+				"Invalid version constraint.",
+				err.Error(),
+			)
+
+			return
+		}
+	}
+
 	// Get Versions list.
 	versions, apiResponse, err := d.client.Version.GetAll()
 	if err != nil {
@@ -122,22 +185,69 @@ func (d *versionsDataSource) Read(
 		return
 	}
 
+	matches := []semverSummary{}
+	state.InvalidVersions = []types.String{}
+
 	for _, vers := range versions {
-		// Map response body to model.
-		version := versionSummary{
-			Codename:     types.StringValue(vers.Codename),
-			CreatedAt:    types.StringValue(vers.CreatedAt),
-			ForkedFrom:   types.StringValue(vers.ForkedFrom),
-			ID:           types.StringValue(vers.ID),
-			IsBeta:       types.BoolValue(vers.IsBeta),
-			IsDeprecated: types.BoolValue(vers.IsDeprecated),
-			IsHidden:     types.BoolValue(vers.IsHidden),
-			IsStable:     types.BoolValue(vers.IsStable),
-			Version:      types.StringValue(vers.Version),
-			VersionClean: types.StringValue(vers.VersionClean),
+		if !versionsIncluded(state, vers) {
+			continue
+		}
+
+		// Parse the version string. Versions that aren't valid semantic versions are reported rather than
+		// dropped, and only excluded when a constraint can't be evaluated for them.
+		semver, err := version.NewVersion(vers.Version)
+		if err != nil {
+			state.InvalidVersions = append(state.InvalidVersions, types.StringValue(vers.Version))
+
+			if constraint != nil {
+				continue
+			}
+		} else if constraint != nil && !constraint.Check(semver) {
+			continue
 		}
 
-		state.Versions = append(state.Versions, version)
+		matches = append(matches, semverSummary{semver: semver, summary: vers})
+	}
+
+	if len(state.InvalidVersions) > 0 && (constraint != nil || state.SortBySemver.ValueBool()) {
+		invalid := make([]string, 0, len(state.InvalidVersions))
+		for _, v := range state.InvalidVersions {
+			invalid = append(invalid, v.ValueString())
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Some versions are not valid semantic versions.",
+			fmt.Sprintf("The following versions could not be parsed as semantic versions and are excluded from "+
+				"constraint matching and the latest version: %s", strings.Join(invalid, ", ")),
+		)
+	}
+
+	if state.SortBySemver.ValueBool() {
+		sort.SliceStable(matches, func(i, j int) bool {
+			// Invalid versions are sorted last.
+			if matches[i].semver == nil || matches[j].semver == nil {
+				return matches[j].semver == nil && matches[i].semver != nil
+			}
+
+			return matches[i].semver.LessThan(matches[j].semver)
+		})
+	}
+
+	state.Versions = []versionSummary{}
+	state.Latest = nil
+
+	var latest *semverSummary
+	for i, match := range matches {
+		state.Versions = append(state.Versions, versionSummaryValue(match.summary))
+
+		if match.semver != nil && (latest == nil || match.semver.GreaterThan(latest.semver)) {
+			latest = &matches[i]
+		}
+	}
+
+	if latest != nil {
+		summary := versionSummaryValue(latest.summary)
+		state.Latest = &summary
 	}
 
 	// The ID attribute is only used by Terraform and the provider internally.
@@ -153,6 +263,47 @@ func (d *versionsDataSource) Read(
 	}
 }
 
+// semverSummary pairs a version summary with its parsed semantic version.
+// The semver is nil if the version string is not a valid semantic version.
+type semverSummary struct {
+	semver  *version.Version
+	summary readme.VersionSummary
+}
+
+// versionsIncluded returns true if a version matches the beta, deprecated, and hidden filters.
+// Unset filters include all versions.
+func versionsIncluded(filters versionsList, vers readme.VersionSummary) bool {
+	if vers.IsBeta && !filters.IncludeBeta.IsNull() && !filters.IncludeBeta.ValueBool() {
+		return false
+	}
+
+	if vers.IsDeprecated && !filters.IncludeDeprecated.IsNull() && !filters.IncludeDeprecated.ValueBool() {
+		return false
+	}
+
+	if vers.IsHidden && !filters.IncludeHidden.IsNull() && !filters.IncludeHidden.ValueBool() {
+		return false
+	}
+
+	return true
+}
+
+// versionSummaryValue maps a version in the list of all versions to the Terraform schema.
+func versionSummaryValue(vers readme.VersionSummary) versionSummary {
+	return versionSummary{
+		Codename:     types.StringValue(vers.Codename),
+		CreatedAt:    types.StringValue(vers.CreatedAt),
+		ForkedFrom:   types.StringValue(vers.ForkedFrom),
+		ID:           types.StringValue(vers.ID),
+		IsBeta:       types.BoolValue(vers.IsBeta),
+		IsDeprecated: types.BoolValue(vers.IsDeprecated),
+		IsHidden:     types.BoolValue(vers.IsHidden),
+		IsStable:     types.BoolValue(vers.IsStable),
+		Version:      types.StringValue(vers.Version),
+		VersionClean: types.StringValue(vers.VersionClean),
+	}
+}
+
 // Configure adds the provider configured client to the data source.
 func (d *versionsDataSource) Configure(
 	ctx context.Context,
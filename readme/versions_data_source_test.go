@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
 	"gopkg.in/h2non/gock.v1"
 )
 
@@ -96,3 +97,90 @@ func TestVersionsDataSource_GetError(t *testing.T) {
 		},
 	})
 }
+
+func TestVersionsDataSource_Filters(t *testing.T) {
+	// mockSemverList is a list of versions in non-semver order with a beta, a hidden, and an invalid version.
+	mockSemverList := []readme.VersionSummary{
+		{ID: "1", Version: "2.1.0", VersionClean: "2.1.0"},
+		{ID: "2", Version: "1.0.0", VersionClean: "1.0.0", IsStable: true},
+		{ID: "3", Version: "2.10.0", VersionClean: "2.10.0", IsBeta: true},
+		{ID: "4", Version: "2.2.0", VersionClean: "2.2.0", IsHidden: true},
+		{ID: "5", Version: "latest-docs", VersionClean: "latest-docs"},
+		{ID: "6", Version: "3.0.0", VersionClean: "3.0.0", IsDeprecated: true},
+	}
+
+	// Close all gocks when completed.
+	defer gock.OffAll()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test a constraint with the beta version excluded.
+			{
+				PreConfig: func() {
+					gock.OffAll()
+					gock.New(testURL).
+						Get("/version").
+						Persist().
+						Reply(200).
+						JSON(mockSemverList)
+				},
+				Config: providerConfig + `data "readme_versions" "test" {
+					constraint     = ">= 2.0, < 3"
+					include_beta   = false
+					sort_by_semver = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readme_versions.test", "versions.#", "2"),
+					resource.TestCheckResourceAttr("data.readme_versions.test", "versions.0.version", "2.1.0"),
+					resource.TestCheckResourceAttr("data.readme_versions.test", "versions.1.version", "2.2.0"),
+					resource.TestCheckResourceAttr("data.readme_versions.test", "latest.version", "2.2.0"),
+					resource.TestCheckResourceAttr("data.readme_versions.test", "latest.id", "4"),
+					resource.TestCheckResourceAttr("data.readme_versions.test", "invalid_versions.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.readme_versions.test",
+						"invalid_versions.0",
+						"latest-docs",
+					),
+				),
+			},
+			// Test sorting without a constraint, excluding hidden and deprecated versions.
+			{
+				Config: providerConfig + `data "readme_versions" "test" {
+					include_hidden     = false
+					include_deprecated = false
+					sort_by_semver     = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readme_versions.test", "versions.#", "4"),
+					resource.TestCheckResourceAttr("data.readme_versions.test", "versions.0.version", "1.0.0"),
+					resource.TestCheckResourceAttr("data.readme_versions.test", "versions.1.version", "2.1.0"),
+					resource.TestCheckResourceAttr("data.readme_versions.test", "versions.2.version", "2.10.0"),
+					resource.TestCheckResourceAttr(
+						"data.readme_versions.test",
+						"versions.3.version",
+						"latest-docs",
+					),
+					resource.TestCheckResourceAttr("data.readme_versions.test", "latest.version", "2.10.0"),
+				),
+			},
+			// Test no versions matching the constraint.
+			{
+				Config: providerConfig + `data "readme_versions" "test" {
+					constraint = ">= 4"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.readme_versions.test", "versions.#", "0"),
+					resource.TestCheckNoResourceAttr("data.readme_versions.test", "latest"),
+				),
+			},
+			// Test an invalid constraint.
+			{
+				Config: providerConfig + `data "readme_versions" "test" {
+					constraint = "not a constraint"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid version constraint"),
+			},
+		},
+	})
+}